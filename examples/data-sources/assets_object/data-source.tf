@@ -1,3 +1,22 @@
 data "assets_object" "example" {
   id = "42"
 }
+
+data "assets_object" "by_key" {
+  object_key = "ITSM-42"
+}
+
+data "assets_object" "by_label" {
+  object_type_id = "7"
+  label          = "switch-01"
+}
+
+data "assets_object" "by_attribute" {
+  object_type_id  = "7"
+  attribute_name  = "Serial Number"
+  attribute_value = "SN-0001"
+}
+
+output "serial_number" {
+  value = data.assets_object.example.values["Serial Number"]
+}
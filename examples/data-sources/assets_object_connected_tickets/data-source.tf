@@ -0,0 +1,12 @@
+data "assets_object_connected_tickets" "server" {
+  object_id = assets_object.server.id
+}
+
+resource "terraform_data" "decommission" {
+  lifecycle {
+    precondition {
+      condition     = !data.assets_object_connected_tickets.server.open_tickets_exist
+      error_message = "The server has open tickets."
+    }
+  }
+}
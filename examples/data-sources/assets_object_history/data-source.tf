@@ -0,0 +1,5 @@
+data "assets_object_history" "example" {
+  object_id      = "42"
+  from           = "2024-01-01T00:00:00Z"
+  attribute_name = "Owner"
+}
@@ -0,0 +1,5 @@
+data "assets_object_references" "dependents" {
+  object_id = "42"
+  direction = "inbound"
+  depth     = 2
+}
@@ -0,0 +1,5 @@
+data "assets_objects" "example" {
+  aql              = "objectType = \"Server\" AND Status = Running"
+  object_schema_id = "1"
+  max_results      = 200
+}
@@ -0,0 +1,7 @@
+data "assets_objectschemas" "itsm" {
+  object_schema_key = "ITSM"
+}
+
+data "assets_objectschemas" "inventories" {
+  name_regex = "^Inventory"
+}
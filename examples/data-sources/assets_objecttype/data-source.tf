@@ -1,3 +1,8 @@
 data "assets_objecttype" "example" {
   id = "42"
 }
+
+data "assets_objecttype" "by_name" {
+  object_schema_id = "1"
+  name             = "Server"
+}
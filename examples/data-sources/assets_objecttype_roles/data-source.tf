@@ -0,0 +1,8 @@
+data "assets_objecttype_roles" "firewall" {
+  object_type_id = "42"
+}
+
+data "assets_objecttype_roles" "firewall_only" {
+  object_type_id    = "42"
+  include_inherited = false
+}
@@ -0,0 +1,4 @@
+data "assets_objecttypeattribute" "example" {
+  object_type_id = "42"
+  name           = "Serial Number"
+}
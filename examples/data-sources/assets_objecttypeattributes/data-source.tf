@@ -1,3 +1,14 @@
 data "assets_objecttypeattributes" "example" {
   objecttype_id = "42"
 }
+
+data "assets_objecttypeattributes" "editable" {
+  objecttype_id     = "42"
+  only_editable     = true
+  exclude_system    = true
+  include_inherited = false
+}
+
+output "serial_number_attribute_id" {
+  value = data.assets_objecttypeattributes.editable.by_name["Serial Number"]
+}
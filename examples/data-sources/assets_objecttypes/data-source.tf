@@ -0,0 +1,10 @@
+data "assets_objecttypes" "servers" {
+  object_schema_id = "1"
+  name             = "Server"
+}
+
+data "assets_objecttypes" "hardware" {
+  object_schema_id      = "1"
+  parent_object_type_id = "12"
+  exclude_abstract      = true
+}
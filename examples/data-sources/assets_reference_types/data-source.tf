@@ -0,0 +1,7 @@
+data "assets_reference_types" "global" {
+}
+
+data "assets_reference_types" "depends_on" {
+  object_schema_id = "1"
+  name             = "Depends on"
+}
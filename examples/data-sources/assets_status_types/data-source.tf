@@ -0,0 +1,7 @@
+data "assets_status_types" "global" {
+}
+
+data "assets_status_types" "obsolete" {
+  object_schema_id = "1"
+  name             = "Obsolete"
+}
@@ -6,5 +6,6 @@ provider "assets" {
   features = {
     destroy_object                  = false
     obsolete_objecttypeattribute_id = "42"
+    obsolete_status                 = "Obsolete"
   }
 }
@@ -0,0 +1,11 @@
+resource "assets_object_import" "example" {
+  path           = "${path.module}/inventory.csv"
+  object_type_id = "42"
+  key_column     = "hostname"
+
+  column_mapping = {
+    "hostname" = "Name"
+    "ip"       = "IP Address"
+    "location" = "Location"
+  }
+}
@@ -0,0 +1,2 @@
+# Object reference can be imported by specifying object_id/object_type_attribute_id/referenced_object_id
+terraform import assets_object_reference.example 42/42/43
@@ -0,0 +1,5 @@
+resource "assets_object_reference" "example" {
+  object_id                = "42"
+  object_type_attribute_id = "42"
+  referenced_object_id     = "43"
+}
@@ -0,0 +1,2 @@
+# Object type ownership can be imported by specifying the object type identifier
+terraform import assets_object_type_ownership.environments 42
@@ -0,0 +1,6 @@
+resource "assets_object_type_ownership" "environments" {
+  object_type_id = "42"
+  aql_filter     = "Status != Obsolete"
+
+  object_ids = [for environment in assets_object.environment : environment.id]
+}
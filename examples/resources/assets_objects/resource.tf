@@ -0,0 +1,13 @@
+resource "assets_objects" "example" {
+  object_type_id   = "42"
+  key_attribute_id = "43"
+
+  objects = {
+    "switch-01" = {
+      "44" = "10.0.0.1"
+    }
+    "switch-02" = {
+      "44" = "10.0.0.2"
+    }
+  }
+}
@@ -2,3 +2,11 @@ resource "assets_objectschema" "example" {
   name              = "example"
   object_schema_key = "EXAMPLE-42"
 }
+
+resource "assets_objectschema" "sandbox" {
+  name              = "sandbox"
+  object_schema_key = "SANDBOX"
+
+  # Destroy the objects of the schema when it is destroyed
+  force_destroy = true
+}
@@ -0,0 +1,2 @@
+# Objectschema definition can be imported by specifying the object schema identifier
+terraform import assets_objectschema_definition.example 42
@@ -0,0 +1,30 @@
+resource "assets_objectschema_definition" "example" {
+  object_schema_id = "42"
+  prune            = false
+  definition       = <<-YAML
+    object_types:
+      - name: Hardware
+        icon_id: "42"
+        abstract: true
+        attributes:
+          - name: Serial number
+            type: text
+            unique: true
+      - name: Server
+        icon_id: "42"
+        parent: Hardware
+        inherited: true
+        attributes:
+          - name: Environment
+            type: select
+            options: [production, staging]
+          - name: Hosted on
+            type: reference
+            reference_object_type: Hardware
+            reference_type_id: "1"
+  YAML
+}
+
+output "server_id" {
+  value = assets_objectschema_definition.example.object_type_ids["Server"]
+}
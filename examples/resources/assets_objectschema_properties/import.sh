@@ -0,0 +1,2 @@
+# Objectschema properties can be imported by specifying the object schema identifier
+terraform import assets_objectschema_properties.example 42
@@ -0,0 +1,7 @@
+resource "assets_objectschema_properties" "example" {
+  object_schema_id               = assets_objectschema.example.id
+  allow_other_object_schema      = true
+  create_objects_custom_field    = false
+  service_desk_customers_enabled = true
+  quick_create_objects           = true
+}
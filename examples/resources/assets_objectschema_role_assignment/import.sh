@@ -0,0 +1,2 @@
+# Objectschema role assignment can be imported by specifying the object schema identifier and the role name
+terraform import assets_objectschema_role_assignment.managers "42/Object Schema Managers"
@@ -0,0 +1,12 @@
+resource "assets_objectschema_role_assignment" "managers" {
+  object_schema_id = assets_objectschema.example.id
+  role             = "Object Schema Managers"
+  users            = ["5b10ac8d82e05b22cc7d4ef5"]
+  groups           = ["assets-admins"]
+}
+
+resource "assets_objectschema_role_assignment" "users" {
+  object_schema_id = assets_objectschema.example.id
+  role             = "Object Schema Users"
+  groups           = ["jira-servicemanagement-users"]
+}
@@ -3,3 +3,11 @@ resource "assets_objecttype" "example" {
   icon_id          = "42"
   object_schema_id = "42"
 }
+
+resource "assets_objecttype" "child" {
+  name                  = "child"
+  icon_id               = "42"
+  object_schema_id      = "42"
+  parent_object_type_id = assets_objecttype.example.id
+  position              = 0
+}
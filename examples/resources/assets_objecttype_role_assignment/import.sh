@@ -0,0 +1,2 @@
+# Objecttype role assignment can be imported by specifying the object type identifier and the role name
+terraform import assets_objecttype_role_assignment.firewall_editors "42/Object Type Editors"
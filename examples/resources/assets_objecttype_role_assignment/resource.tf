@@ -0,0 +1,5 @@
+resource "assets_objecttype_role_assignment" "firewall_editors" {
+  object_type_id = assets_objecttype.firewall.id
+  role           = "Object Type Editors"
+  groups         = ["network-team"]
+}
@@ -1,2 +1,6 @@
-# Objectattribute can be imported by specifying the identifier
-terraform import assets_objectattribute.example 42
+# Objecttypeattribute can be imported by specifying the object type and the attribute identifier or name
+terraform import assets_objecttypeattribute.example 42/1337
+terraform import assets_objecttypeattribute.example "42/Serial Number"
+
+# or the attribute identifier alone, which is looked up in every object schema
+terraform import assets_objecttypeattribute.example 1337
@@ -3,3 +3,34 @@ resource "assets_objecttypeattribute" "example" {
   name           = "example"
   type           = 0
 }
+
+resource "assets_objecttypeattribute" "environment" {
+  object_type_id = "42"
+  name           = "Environment"
+  position       = 4
+
+  default = {
+    kind    = "select"
+    options = ["Production", "Staging", "Development"]
+  }
+}
+
+resource "assets_objecttypeattribute" "owner" {
+  object_type_id = "42"
+  name           = "Owner"
+
+  reference = {
+    object_type_id    = "43"
+    reference_type_id = "1"
+    aql_filter        = "Status = Active"
+  }
+}
+
+resource "assets_objecttypeattribute" "status" {
+  object_type_id = "42"
+  name           = "Lifecycle"
+
+  status = {
+    status_type_ids = ["1", "2"]
+  }
+}
@@ -0,0 +1,2 @@
+# Reference type can be imported by specifying the identifier
+terraform import assets_reference_type.depends_on 42
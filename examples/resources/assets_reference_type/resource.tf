@@ -0,0 +1,6 @@
+resource "assets_reference_type" "depends_on" {
+  name             = "Depends on"
+  description      = "The object cannot run without the referenced object"
+  color            = "ff5630"
+  object_schema_id = "1"
+}
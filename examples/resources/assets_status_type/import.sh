@@ -0,0 +1,2 @@
+# Status type can be imported by specifying the identifier
+terraform import assets_status_type.decommissioned 42
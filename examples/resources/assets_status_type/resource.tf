@@ -0,0 +1,6 @@
+resource "assets_status_type" "decommissioned" {
+  name             = "Decommissioned"
+  description      = "The object is no longer in use"
+  category         = 0
+  object_schema_id = "1"
+}
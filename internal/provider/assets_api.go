@@ -0,0 +1,452 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// Helpers for the Assets endpoints (or response fields) that are not covered
+// by go-atlassian. They go through the same client, so authentication and
+// error handling are identical to the go-atlassian services.
+
+func assetsCall(ctx context.Context, client *assets.Client, method, endpoint string, payload interface{}, structure interface{}) (*models.ResponseScheme, error) {
+	req, err := client.NewRequest(ctx, method, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Call(req, structure)
+}
+
+// Object attribute as returned by the API, including the referenced object
+// of reference attributes.
+type objectAttributeReferenceScheme struct {
+	ID                    string                                 `json:"id,omitempty"`
+	ObjectTypeAttributeId string                                 `json:"objectTypeAttributeId,omitempty"`
+	ObjectTypeAttribute   *models.ObjectTypeAttributeScheme      `json:"objectTypeAttribute,omitempty"`
+	ObjectAttributeValues []*objectAttributeValueReferenceScheme `json:"objectAttributeValues,omitempty"`
+}
+
+type objectAttributeValueReferenceScheme struct {
+	Value            string                  `json:"value,omitempty"`
+	DisplayValue     string                  `json:"displayValue,omitempty"`
+	SearchValue      string                  `json:"searchValue,omitempty"`
+	ReferencedType   bool                    `json:"referencedType,omitempty"`
+	ReferencedObject *referencedObjectScheme `json:"referencedObject,omitempty"`
+}
+
+type referencedObjectScheme struct {
+	ID         string                   `json:"id,omitempty"`
+	Label      string                   `json:"label,omitempty"`
+	ObjectKey  string                   `json:"objectKey,omitempty"`
+	ObjectType *models.ObjectTypeScheme `json:"objectType,omitempty"`
+}
+
+// The object update payload of go-atlassian omits empty value lists, which
+// makes it impossible to clear an attribute.
+type objectAttributeUpdatePayload struct {
+	ObjectTypeId string                            `json:"objectTypeId"`
+	Attributes   []*objectAttributeUpdateAttribute `json:"attributes"`
+}
+
+type objectAttributeUpdateAttribute struct {
+	ObjectTypeAttributeId string                                      `json:"objectTypeAttributeId"`
+	ObjectAttributeValues []*models.ObjectPayloadAttributeValueScheme `json:"objectAttributeValues"`
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/object/{id}/attributes
+func getObjectAttributesWithReferences(ctx context.Context, client *assets.Client, workspaceId, objectId string) ([]*objectAttributeReferenceScheme, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/object/%v/attributes", workspaceId, objectId)
+
+	var attributes []*objectAttributeReferenceScheme
+	response, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, &attributes)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return attributes, response, nil
+}
+
+// PUT /jsm/assets/workspace/{workspaceId}/v1/object/{id}
+func setObjectAttributeValues(ctx context.Context, client *assets.Client, workspaceId, objectTypeId, objectId, objectTypeAttributeId string, values []string) (*models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/object/%v", workspaceId, objectId)
+
+	payloadValues := make([]*models.ObjectPayloadAttributeValueScheme, 0, len(values))
+	for _, value := range values {
+		payloadValues = append(payloadValues, &models.ObjectPayloadAttributeValueScheme{
+			Value: value,
+		})
+	}
+
+	payload := objectAttributeUpdatePayload{
+		ObjectTypeId: objectTypeId,
+		Attributes: []*objectAttributeUpdateAttribute{
+			{
+				ObjectTypeAttributeId: objectTypeAttributeId,
+				ObjectAttributeValues: payloadValues,
+			},
+		},
+	}
+
+	return assetsCall(ctx, client, http.MethodPut, endpoint, &payload, nil)
+}
+
+// ObjectSchema.List only returns the first page of object schemas.
+//
+// GET /jsm/assets/workspace/{workspaceId}/v1/objectschema/list
+func listObjectSchemas(ctx context.Context, client *assets.Client, workspaceId string) ([]*models.ObjectSchemaScheme, error) {
+	const pageSize = 50
+
+	var objectSchemas []*models.ObjectSchemaScheme
+	for startAt := 0; ; startAt += pageSize {
+		endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objectschema/list?startAt=%d&maxResults=%d", workspaceId, startAt, pageSize)
+
+		page := new(models.ObjectSchemaPageScheme)
+		if _, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, page); err != nil {
+			return nil, err
+		}
+
+		objectSchemas = append(objectSchemas, page.Values...)
+
+		if len(page.Values) < pageSize || len(objectSchemas) >= page.Total {
+			return objectSchemas, nil
+		}
+	}
+}
+
+// Status type as returned by the config/statustype endpoints.
+type statusTypeScheme struct {
+	WorkspaceId    string `json:"workspaceId,omitempty"`
+	GlobalId       string `json:"globalId,omitempty"`
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Category       int    `json:"category"`
+	ObjectSchemaId string `json:"objectSchemaId,omitempty"`
+}
+
+type statusTypePayload struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Category       int    `json:"category"`
+	ObjectSchemaId string `json:"objectSchemaId,omitempty"`
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/config/statustype
+//
+// The global status types are returned when objectSchemaId is empty.
+func listStatusTypes(ctx context.Context, client *assets.Client, workspaceId, objectSchemaId string) ([]*statusTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/statustype", workspaceId)
+	if objectSchemaId != "" {
+		endpoint += "?objectSchemaId=" + url.QueryEscape(objectSchemaId)
+	}
+
+	var statusTypes []*statusTypeScheme
+	if _, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, &statusTypes); err != nil {
+		return nil, err
+	}
+
+	return statusTypes, nil
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/config/statustype/{id}
+func getStatusType(ctx context.Context, client *assets.Client, workspaceId, id string) (*statusTypeScheme, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/statustype/%v", workspaceId, id)
+
+	statusType := new(statusTypeScheme)
+	response, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, statusType)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return statusType, response, nil
+}
+
+// POST /jsm/assets/workspace/{workspaceId}/v1/config/statustype
+func createStatusType(ctx context.Context, client *assets.Client, workspaceId string, payload *statusTypePayload) (*statusTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/statustype", workspaceId)
+
+	statusType := new(statusTypeScheme)
+	if _, err := assetsCall(ctx, client, http.MethodPost, endpoint, payload, statusType); err != nil {
+		return nil, err
+	}
+
+	return statusType, nil
+}
+
+// PUT /jsm/assets/workspace/{workspaceId}/v1/config/statustype/{id}
+func updateStatusType(ctx context.Context, client *assets.Client, workspaceId, id string, payload *statusTypePayload) (*statusTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/statustype/%v", workspaceId, id)
+
+	statusType := new(statusTypeScheme)
+	if _, err := assetsCall(ctx, client, http.MethodPut, endpoint, payload, statusType); err != nil {
+		return nil, err
+	}
+
+	return statusType, nil
+}
+
+// DELETE /jsm/assets/workspace/{workspaceId}/v1/config/statustype/{id}
+func deleteStatusType(ctx context.Context, client *assets.Client, workspaceId, id string) error {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/statustype/%v", workspaceId, id)
+
+	_, err := assetsCall(ctx, client, http.MethodDelete, endpoint, nil, nil)
+	return err
+}
+
+// Reference type as returned by the config/referencetype endpoints.
+type referenceTypeScheme struct {
+	WorkspaceId    string `json:"workspaceId,omitempty"`
+	GlobalId       string `json:"globalId,omitempty"`
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Color          string `json:"color,omitempty"`
+	Url16          string `json:"url16,omitempty"`
+	Removable      bool   `json:"removable,omitempty"`
+	ObjectSchemaId string `json:"objectSchemaId,omitempty"`
+}
+
+type referenceTypePayload struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Color          string `json:"color"`
+	ObjectSchemaId string `json:"objectSchemaId,omitempty"`
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/config/referencetype
+//
+// The global reference types are returned when objectSchemaId is empty.
+func listReferenceTypes(ctx context.Context, client *assets.Client, workspaceId, objectSchemaId string) ([]*referenceTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/referencetype", workspaceId)
+	if objectSchemaId != "" {
+		endpoint += "?objectSchemaId=" + url.QueryEscape(objectSchemaId)
+	}
+
+	var referenceTypes []*referenceTypeScheme
+	if _, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, &referenceTypes); err != nil {
+		return nil, err
+	}
+
+	return referenceTypes, nil
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/config/referencetype/{id}
+func getReferenceType(ctx context.Context, client *assets.Client, workspaceId, id string) (*referenceTypeScheme, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/referencetype/%v", workspaceId, id)
+
+	referenceType := new(referenceTypeScheme)
+	response, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, referenceType)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return referenceType, response, nil
+}
+
+// POST /jsm/assets/workspace/{workspaceId}/v1/config/referencetype
+func createReferenceType(ctx context.Context, client *assets.Client, workspaceId string, payload *referenceTypePayload) (*referenceTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/referencetype", workspaceId)
+
+	referenceType := new(referenceTypeScheme)
+	if _, err := assetsCall(ctx, client, http.MethodPost, endpoint, payload, referenceType); err != nil {
+		return nil, err
+	}
+
+	return referenceType, nil
+}
+
+// PUT /jsm/assets/workspace/{workspaceId}/v1/config/referencetype/{id}
+func updateReferenceType(ctx context.Context, client *assets.Client, workspaceId, id string, payload *referenceTypePayload) (*referenceTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/referencetype/%v", workspaceId, id)
+
+	referenceType := new(referenceTypeScheme)
+	if _, err := assetsCall(ctx, client, http.MethodPut, endpoint, payload, referenceType); err != nil {
+		return nil, err
+	}
+
+	return referenceType, nil
+}
+
+// DELETE /jsm/assets/workspace/{workspaceId}/v1/config/referencetype/{id}
+func deleteReferenceType(ctx context.Context, client *assets.Client, workspaceId, id string) error {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/referencetype/%v", workspaceId, id)
+
+	_, err := assetsCall(ctx, client, http.MethodDelete, endpoint, nil, nil)
+	return err
+}
+
+type objectTypeAttributeMovePayload struct {
+	Position int `json:"position"`
+}
+
+// POST /jsm/assets/workspace/{workspaceId}/v1/objecttypeattribute/{objectTypeId}/{id}/move
+func moveObjectTypeAttribute(ctx context.Context, client *assets.Client, workspaceId, objectTypeId, id string, position int) error {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objecttypeattribute/%v/%v/move", workspaceId, objectTypeId, id)
+
+	_, err := assetsCall(ctx, client, http.MethodPost, endpoint, &objectTypeAttributeMovePayload{Position: position}, nil)
+	return err
+}
+
+// ObjectType.Position omits a position of 0, which makes it impossible to move
+// an object type first.
+type objectTypePositionPayload struct {
+	ToObjectTypeId string `json:"toObjectTypeId,omitempty"`
+	Position       int    `json:"position"`
+}
+
+// POST /jsm/assets/workspace/{workspaceId}/v1/objecttype/{id}/position
+func moveObjectType(ctx context.Context, client *assets.Client, workspaceId, id, toObjectTypeId string, position int) (*models.ObjectTypeScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objecttype/%v/position", workspaceId, id)
+
+	objectType := new(models.ObjectTypeScheme)
+	_, err := assetsCall(ctx, client, http.MethodPost, endpoint, &objectTypePositionPayload{ToObjectTypeId: toObjectTypeId, Position: position}, objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	return objectType, nil
+}
+
+// Properties of an object schema, as returned by the objectschema property
+// endpoint.
+type objectSchemaPropertiesScheme struct {
+	ID                          string `json:"id,omitempty"`
+	ObjectSchemaId              string `json:"objectSchemaId,omitempty"`
+	AllowOtherObjectSchema      bool   `json:"allowOtherObjectSchema"`
+	CreateObjectsCustomField    bool   `json:"createObjectsCustomField"`
+	ServiceDescCustomersEnabled bool   `json:"serviceDescCustomersEnabled"`
+	QuickCreateObjects          bool   `json:"quickCreateObjects"`
+	ValidateQuickCreate         bool   `json:"validateQuickCreate"`
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/objectschema/{id}/property
+func getObjectSchemaProperties(ctx context.Context, client *assets.Client, workspaceId, objectSchemaId string) (*objectSchemaPropertiesScheme, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objectschema/%v/property", workspaceId, objectSchemaId)
+
+	properties := new(objectSchemaPropertiesScheme)
+	response, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, properties)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return properties, response, nil
+}
+
+// POST /jsm/assets/workspace/{workspaceId}/v1/objectschema/{id}/property
+func updateObjectSchemaProperties(ctx context.Context, client *assets.Client, workspaceId, objectSchemaId string, payload *objectSchemaPropertiesScheme) error {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objectschema/%v/property", workspaceId, objectSchemaId)
+
+	_, err := assetsCall(ctx, client, http.MethodPost, endpoint, payload, nil)
+	return err
+}
+
+// Role as returned by the config/role endpoints. The actors are the users and
+// groups the role is granted to.
+type roleScheme struct {
+	ID          int                `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Actors      []*roleActorScheme `json:"actors,omitempty"`
+}
+
+type roleActorScheme struct {
+	ID          int                   `json:"id,omitempty"`
+	DisplayName string                `json:"displayName,omitempty"`
+	Type        string                `json:"type,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	ActorUser   *roleActorUserScheme  `json:"actorUser,omitempty"`
+	ActorGroup  *roleActorGroupScheme `json:"actorGroup,omitempty"`
+}
+
+type roleActorUserScheme struct {
+	AccountId string `json:"accountId,omitempty"`
+}
+
+type roleActorGroupScheme struct {
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+const (
+	roleActorUser  = "atlassian-user-role-actor"
+	roleActorGroup = "atlassian-group-role-actor"
+)
+
+type roleActorsPayload struct {
+	CategorisedActors map[string][]string `json:"categorisedActors"`
+}
+
+// Returns the account id of a user actor, or the name of a group actor.
+func (a *roleActorScheme) identifier() string {
+	if a.ActorUser != nil && a.ActorUser.AccountId != "" {
+		return a.ActorUser.AccountId
+	}
+	if a.ActorGroup != nil && a.ActorGroup.Name != "" {
+		return a.ActorGroup.Name
+	}
+	return a.Name
+}
+
+// Lists the roles of an object schema or object type, scope being
+// objectschema or objecttype. The endpoint maps each role name to the URL of
+// the role, whose last segment is the role id.
+//
+// GET /jsm/assets/workspace/{workspaceId}/v1/config/role/{scope}/{id}
+func listRoles(ctx context.Context, client *assets.Client, workspaceId, scope, id string) (map[string]string, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/role/%v/%v", workspaceId, scope, id)
+
+	var urls map[string]string
+	if _, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, &urls); err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string]string, len(urls))
+	for name, roleUrl := range urls {
+		roles[name] = roleUrl[strings.LastIndex(roleUrl, "/")+1:]
+	}
+
+	return roles, nil
+}
+
+// GET /jsm/assets/workspace/{workspaceId}/v1/config/role/{id}
+func getRole(ctx context.Context, client *assets.Client, workspaceId, id string) (*roleScheme, *models.ResponseScheme, error) {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/role/%v", workspaceId, id)
+
+	role := new(roleScheme)
+	response, err := assetsCall(ctx, client, http.MethodGet, endpoint, nil, role)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return role, response, nil
+}
+
+// Replaces the actors of a role.
+//
+// PUT /jsm/assets/workspace/{workspaceId}/v1/config/role/{id}
+func setRoleActors(ctx context.Context, client *assets.Client, workspaceId, id string, users, groups []string) error {
+	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/config/role/%v", workspaceId, id)
+
+	if users == nil {
+		users = []string{}
+	}
+	if groups == nil {
+		groups = []string{}
+	}
+	payload := roleActorsPayload{
+		CategorisedActors: map[string][]string{
+			roleActorUser:  users,
+			roleActorGroup: groups,
+		},
+	}
+
+	_, err := assetsCall(ctx, client, http.MethodPut, endpoint, &payload, nil)
+	return err
+}
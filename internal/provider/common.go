@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func FillInformationsForDataObject(ctx context.Context, object *objectDataResourceModel, assetsObject *models.ObjectScheme) diag.Diagnostics {
@@ -121,6 +126,11 @@ func FillInformationsForDataObject(ctx context.Context, object *objectDataResour
 	}
 
 	object.Attributes, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: objectAttributeAttrTypes()}, attributes)
+	if diags.HasError() {
+		return diags
+	}
+
+	object.Values, object.RawValues, diags = objectValuesByName(ctx, assetsObject.Attributes)
 	return diags
 }
 
@@ -235,6 +245,11 @@ func FillInformationsForObject(ctx context.Context, object *objectResourceModel,
 	}
 
 	object.Attributes, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: objectAttributeAttrTypes()}, attributes)
+	if diags.HasError() {
+		return diags
+	}
+
+	object.Values, object.RawValues, diags = objectValuesByName(ctx, assetsObject.Attributes)
 	return diags
 }
 
@@ -263,6 +278,24 @@ func FillInformationsForObjectType(objectType *objectTypeResourceModel, assetsOb
 	objectType.ParentObjectTypeInherited = types.BoolValue(assetsObjectType.ParentObjectTypeInherited)
 }
 
+func FillInformationsForDataObjectType(objectType *objectTypeDataModel, assetsObjectType *models.ObjectTypeScheme) {
+	objectType.WorkspaceId = types.StringValue(assetsObjectType.WorkspaceId)
+	objectType.GlobalId = types.StringValue(assetsObjectType.GlobalId)
+	objectType.Id = types.StringValue(assetsObjectType.Id)
+	objectType.Name = types.StringValue(assetsObjectType.Name)
+	objectType.Description = types.StringValue(assetsObjectType.Description)
+	objectType.IconId = types.StringValue(assetsObjectType.Icon.ID)
+	objectType.Position = types.Int64Value(int64(assetsObjectType.Position))
+	objectType.Created = types.StringValue(assetsObjectType.Created)
+	objectType.Updated = types.StringValue(assetsObjectType.Updated)
+	objectType.ObjectCount = types.Int64Value(int64(assetsObjectType.ObjectCount))
+	objectType.ParentObjectTypeId = types.StringValue(assetsObjectType.ParentObjectTypeId)
+	objectType.ObjectSchemaId = types.StringValue(assetsObjectType.ObjectSchemaId)
+	objectType.Inherited = types.BoolValue(assetsObjectType.Inherited)
+	objectType.AbstractObjectType = types.BoolValue(assetsObjectType.AbstractObjectType)
+	objectType.ParentObjectTypeInherited = types.BoolValue(assetsObjectType.ParentObjectTypeInherited)
+}
+
 func FillInformationsForObjectTypeAttribute(ctx context.Context, objectTypeAttribute *objectTypeAttributeResourceModel, assetsObjectTypeAttribute *models.ObjectTypeAttributeScheme) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -328,6 +361,78 @@ func FillInformationsForObjectTypeAttribute(ctx context.Context, objectTypeAttri
 	objectTypeAttribute.Options = types.StringValue(assetsObjectTypeAttribute.Options)
 	objectTypeAttribute.Position = types.Int64Value(int64(assetsObjectTypeAttribute.Position))
 
+	return FillInformationsForObjectTypeAttributeBlocks(ctx, objectTypeAttribute, assetsObjectTypeAttribute)
+}
+
+// Refreshes the typed block in use. Blocks which are not configured are left
+// null, the identifiers of a reference are only overwritten when returned.
+func FillInformationsForObjectTypeAttributeBlocks(ctx context.Context, objectTypeAttribute *objectTypeAttributeResourceModel, assetsObjectTypeAttribute *models.ObjectTypeAttributeScheme) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeValueMulti := assetsObjectTypeAttribute.TypeValueMulti
+	if typeValueMulti == nil {
+		typeValueMulti = []string{}
+	}
+
+	switch {
+	case !objectTypeAttribute.Default.IsNull():
+		var block defaultBlockModel
+		diags = objectTypeAttribute.Default.As(ctx, &block, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return diags
+		}
+
+		if assetsObjectTypeAttribute.DefaultType != nil && assetsObjectTypeAttribute.DefaultType.ID >= 0 && assetsObjectTypeAttribute.DefaultType.ID < len(defaultTypeKinds) {
+			block.Kind = types.StringValue(defaultTypeKinds[assetsObjectTypeAttribute.DefaultType.ID])
+		}
+
+		options := []string{}
+		if assetsObjectTypeAttribute.Options != "" {
+			options = strings.Split(assetsObjectTypeAttribute.Options, ",")
+		}
+		block.Options, diags = types.ListValueFrom(ctx, types.StringType, options)
+		if diags.HasError() {
+			return diags
+		}
+
+		objectTypeAttribute.Default, diags = types.ObjectValueFrom(ctx, defaultBlockAttrTypes(), block)
+
+	case !objectTypeAttribute.Reference.IsNull():
+		var block referenceBlockModel
+		diags = objectTypeAttribute.Reference.As(ctx, &block, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return diags
+		}
+
+		if assetsObjectTypeAttribute.ReferenceObjectTypeId != "" {
+			block.ObjectTypeId = types.StringValue(assetsObjectTypeAttribute.ReferenceObjectTypeId)
+		}
+		if assetsObjectTypeAttribute.AdditionalValue != "" {
+			block.ReferenceTypeId = types.StringValue(assetsObjectTypeAttribute.AdditionalValue)
+		}
+		block.AqlFilter = types.StringValue(assetsObjectTypeAttribute.Iql)
+
+		objectTypeAttribute.Reference, diags = types.ObjectValueFrom(ctx, referenceBlockAttrTypes(), block)
+
+	case !objectTypeAttribute.User.IsNull():
+		var block userBlockModel
+		block.Groups, diags = types.ListValueFrom(ctx, types.StringType, typeValueMulti)
+		if diags.HasError() {
+			return diags
+		}
+
+		objectTypeAttribute.User, diags = types.ObjectValueFrom(ctx, userBlockAttrTypes(), block)
+
+	case !objectTypeAttribute.Status.IsNull():
+		var block statusBlockModel
+		block.StatusTypeIds, diags = types.ListValueFrom(ctx, types.StringType, typeValueMulti)
+		if diags.HasError() {
+			return diags
+		}
+
+		objectTypeAttribute.Status, diags = types.ObjectValueFrom(ctx, statusBlockAttrTypes(), block)
+	}
+
 	return diags
 }
 
@@ -337,6 +442,10 @@ func FillInformationsForDataObjectTypeAttribute(ctx context.Context, objectTypeA
 	objectTypeAttribute.WorkspaceId = types.StringValue(assetsObjectTypeAttribute.WorkspaceId)
 	objectTypeAttribute.GlobalId = types.StringValue(assetsObjectTypeAttribute.GlobalId)
 	objectTypeAttribute.Id = types.StringValue(assetsObjectTypeAttribute.ID)
+	objectTypeAttribute.ObjectTypeId = types.StringValue("")
+	if assetsObjectTypeAttribute.ObjectType != nil {
+		objectTypeAttribute.ObjectTypeId = types.StringValue(assetsObjectTypeAttribute.ObjectType.Id)
+	}
 	objectTypeAttribute.Name = types.StringValue(assetsObjectTypeAttribute.Name)
 	objectTypeAttribute.Label = types.BoolValue(assetsObjectTypeAttribute.Label)
 	objectTypeAttribute.Type = types.Int64Value(int64(assetsObjectTypeAttribute.Type))
@@ -413,3 +522,214 @@ func FillInformationsForObjectSchema(objectSchema *objectSchemaResourceModel, as
 	objectSchema.ObjectTypeCount = types.Int64Value(int64(assetsObjectSchema.ObjectTypeCount))
 	objectSchema.CanManage = types.BoolValue(assetsObjectSchema.CanManage)
 }
+
+func FillInformationsForDataObjectSchema(objectSchema *objectSchemaDataModel, assetsObjectSchema *models.ObjectSchemaScheme) {
+	objectSchema.WorkspaceId = types.StringValue(assetsObjectSchema.WorkspaceId)
+	objectSchema.GlobalId = types.StringValue(assetsObjectSchema.GlobalId)
+	objectSchema.Id = types.StringValue(assetsObjectSchema.Id)
+	objectSchema.Name = types.StringValue(assetsObjectSchema.Name)
+	objectSchema.ObjectSchemaKey = types.StringValue(assetsObjectSchema.ObjectSchemaKey)
+	objectSchema.Description = types.StringValue(assetsObjectSchema.Description)
+	objectSchema.Status = types.StringValue(assetsObjectSchema.Status)
+	objectSchema.Created = types.StringValue(assetsObjectSchema.Created)
+	objectSchema.Updated = types.StringValue(assetsObjectSchema.Updated)
+	objectSchema.ObjectCount = types.Int64Value(int64(assetsObjectSchema.ObjectCount))
+	objectSchema.ObjectTypeCount = types.Int64Value(int64(assetsObjectSchema.ObjectTypeCount))
+	objectSchema.CanManage = types.BoolValue(assetsObjectSchema.CanManage)
+}
+
+func FillInformationsForObjectSchemaProperties(properties *objectSchemaPropertiesResourceModel, objectSchemaId string, assetsProperties *objectSchemaPropertiesScheme) {
+	properties.Id = types.StringValue(objectSchemaId)
+	properties.ObjectSchemaId = types.StringValue(objectSchemaId)
+	properties.AllowOtherObjectSchema = types.BoolValue(assetsProperties.AllowOtherObjectSchema)
+	properties.CreateObjectsCustomField = types.BoolValue(assetsProperties.CreateObjectsCustomField)
+	properties.ServiceDeskCustomersEnabled = types.BoolValue(assetsProperties.ServiceDescCustomersEnabled)
+	properties.QuickCreateObjects = types.BoolValue(assetsProperties.QuickCreateObjects)
+	properties.ValidateQuickCreate = types.BoolValue(assetsProperties.ValidateQuickCreate)
+}
+
+func FillInformationsForStatusType(statusType *statusTypeResourceModel, assetsStatusType *statusTypeScheme) {
+	statusType.WorkspaceId = types.StringValue(assetsStatusType.WorkspaceId)
+	statusType.GlobalId = types.StringValue(assetsStatusType.GlobalId)
+	statusType.Id = types.StringValue(assetsStatusType.ID)
+	statusType.Name = types.StringValue(assetsStatusType.Name)
+	statusType.Description = types.StringValue(assetsStatusType.Description)
+	statusType.Category = types.Int64Value(int64(assetsStatusType.Category))
+	statusType.ObjectSchemaId = types.StringNull()
+	if assetsStatusType.ObjectSchemaId != "" {
+		statusType.ObjectSchemaId = types.StringValue(assetsStatusType.ObjectSchemaId)
+	}
+}
+
+func FillInformationsForReferenceType(referenceType *referenceTypeResourceModel, assetsReferenceType *referenceTypeScheme) {
+	referenceType.WorkspaceId = types.StringValue(assetsReferenceType.WorkspaceId)
+	referenceType.GlobalId = types.StringValue(assetsReferenceType.GlobalId)
+	referenceType.Id = types.StringValue(assetsReferenceType.ID)
+	referenceType.Name = types.StringValue(assetsReferenceType.Name)
+	referenceType.Description = types.StringValue(assetsReferenceType.Description)
+	referenceType.Color = types.StringValue(assetsReferenceType.Color)
+	referenceType.Url16 = types.StringValue(assetsReferenceType.Url16)
+	referenceType.Removable = types.BoolValue(assetsReferenceType.Removable)
+	referenceType.ObjectSchemaId = types.StringNull()
+	if assetsReferenceType.ObjectSchemaId != "" {
+		referenceType.ObjectSchemaId = types.StringValue(assetsReferenceType.ObjectSchemaId)
+	}
+}
+
+// Maps the attribute values of an object by attribute name: the display
+// values joined with ", " and the raw values as a list.
+func objectValuesByName(ctx context.Context, attributes []*models.ObjectAttributeScheme) (types.Map, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make(map[string]string, len(attributes))
+	rawValues := make(map[string][]string, len(attributes))
+	for _, att := range attributes {
+		if att.ObjectTypeAttribute == nil {
+			continue
+		}
+
+		displayValues := make([]string, 0, len(att.ObjectAttributeValues))
+		raw := make([]string, 0, len(att.ObjectAttributeValues))
+		for _, value := range att.ObjectAttributeValues {
+			displayValues = append(displayValues, value.DisplayValue)
+			if value.Value != "" {
+				raw = append(raw, value.Value)
+			} else {
+				raw = append(raw, value.SearchValue)
+			}
+		}
+
+		values[att.ObjectTypeAttribute.Name] = strings.Join(displayValues, ", ")
+		rawValues[att.ObjectTypeAttribute.Name] = raw
+	}
+
+	valuesMap, d := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	rawValuesMap, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, rawValues)
+	diags.Append(d...)
+
+	return valuesMap, rawValuesMap, diags
+}
+
+// Returns every object matching the AQL query, following the pagination of the
+// AQL endpoint.
+func searchObjects(ctx context.Context, client *assets.Client, workspaceId, aql string, includeAttributes bool) ([]*models.ObjectScheme, error) {
+	const pageSize = 100
+
+	var objects []*models.ObjectScheme
+	for startAt := 0; ; startAt += pageSize {
+		page, _, err := client.Object.Filter(ctx, workspaceId, aql, includeAttributes, startAt, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, page.Values...)
+
+		if page.IsLast || len(page.Values) < pageSize {
+			return objects, nil
+		}
+	}
+}
+
+// Deletes the objects matched by the AQL query a page at a time, before the
+// object type or schema holding them is deleted. The objects are deleted
+// whatever features.destroy_object, as they don't outlive their object type
+// anyway. Progress is logged after each page.
+func destroyContainedObjects(ctx context.Context, client *assets.Client, workspaceId string, aql string) error {
+	const pageSize = 100
+
+	deleted := map[string]bool{}
+	for {
+		// Deleted objects leave the results, so the first page is read each time
+		page, _, err := client.Object.Filter(ctx, workspaceId, aql, false, 0, pageSize)
+		if err != nil {
+			return err
+		}
+
+		destroyed := 0
+		for _, object := range page.Values {
+			// The search index may still list objects deleted by a previous page
+			if deleted[object.ID] {
+				continue
+			}
+
+			_, err := client.Object.Delete(ctx, workspaceId, object.ID)
+			if err != nil {
+				return fmt.Errorf("could not delete object %s: %w", object.ID, err)
+			}
+			deleted[object.ID] = true
+			destroyed++
+		}
+
+		if destroyed == 0 {
+			return nil
+		}
+
+		tflog.Info(ctx, "Destroyed contained objects", map[string]interface{}{
+			"aql":       aql,
+			"destroyed": len(deleted),
+			"total":     page.Total,
+		})
+	}
+}
+
+// Finds the id of the role of an object schema or object type by name.
+func lookupRoleId(ctx context.Context, client *assets.Client, workspaceId, scope, id, name string) (string, error) {
+	roles, err := listRoles(ctx, client, workspaceId, scope, id)
+	if err != nil {
+		return "", err
+	}
+
+	roleId, ok := roles[name]
+	if !ok {
+		names := make([]string, 0, len(roles))
+		for roleName := range roles {
+			names = append(names, roleName)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("no role named %q in %s %s, available roles: %s", name, scope, id, strings.Join(names, ", "))
+	}
+
+	return roleId, nil
+}
+
+// Replaces the actors of a role with the given users and groups.
+func assignRoleActors(ctx context.Context, client *assets.Client, workspaceId, roleId string, users, groups types.Set) error {
+	var userIds, groupNames []string
+	for _, value := range users.Elements() {
+		userIds = append(userIds, value.(types.String).ValueString())
+	}
+	for _, value := range groups.Elements() {
+		groupNames = append(groupNames, value.(types.String).ValueString())
+	}
+
+	return setRoleActors(ctx, client, workspaceId, roleId, userIds, groupNames)
+}
+
+// Splits the actors of a role into the account ids of its users and the names
+// of its groups.
+func roleActorSets(ctx context.Context, role *roleScheme) (types.Set, types.Set, diag.Diagnostics) {
+	users := []string{}
+	groups := []string{}
+	for _, actor := range role.Actors {
+		switch actor.Type {
+		case roleActorUser:
+			users = append(users, actor.identifier())
+		case roleActorGroup:
+			groups = append(groups, actor.identifier())
+		}
+	}
+
+	userSet, diags := types.SetValueFrom(ctx, types.StringType, users)
+	if diags.HasError() {
+		return userSet, types.SetNull(types.StringType), diags
+	}
+
+	groupSet, diags := types.SetValueFrom(ctx, types.StringType, groups)
+	return userSet, groupSet, diags
+}
+
+// Quotes a value for use in an AQL query.
+func aqlQuote(value string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), `"`, `\"`) + `"`
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectConnectedTicketsDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectConnectedTicketsDataSource{}
+)
+
+type objectConnectedTicketsDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectConnectedTicketsDataSourceModel struct {
+	ObjectId         types.String           `tfsdk:"object_id"`
+	StatusCategory   types.String           `tfsdk:"status_category"`
+	AllTicketsQuery  types.String           `tfsdk:"all_tickets_query"`
+	OpenTicketsExist types.Bool             `tfsdk:"open_tickets_exist"`
+	Tickets          []connectedTicketModel `tfsdk:"tickets"`
+}
+
+type connectedTicketModel struct {
+	Id             types.String `tfsdk:"id"`
+	Key            types.String `tfsdk:"key"`
+	Summary        types.String `tfsdk:"summary"`
+	Status         types.String `tfsdk:"status"`
+	StatusCategory types.String `tfsdk:"status_category"`
+	Type           types.String `tfsdk:"type"`
+	Priority       types.String `tfsdk:"priority"`
+	Reporter       types.String `tfsdk:"reporter"`
+	Created        types.String `tfsdk:"created"`
+	Updated        types.String `tfsdk:"updated"`
+}
+
+// NewObjectConnectedTicketsDataSource is a helper function to simplify the provider implementation.
+func NewObjectConnectedTicketsDataSource() datasource.DataSource {
+	return &objectConnectedTicketsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectConnectedTicketsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_connected_tickets"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectConnectedTicketsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectConnectedTicketsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The object id",
+			},
+			"status_category": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("new", "indeterminate", "done"),
+				},
+				Description: "Only return the tickets in this status category: new, indeterminate (in progress) or done",
+			},
+			"all_tickets_query": schema.StringAttribute{
+				Computed:    true,
+				Description: "The JQL query returning all the tickets connected to the object",
+			},
+			"open_tickets_exist": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a connected ticket is not done, regardless of status_category",
+			},
+			"tickets": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"key": schema.StringAttribute{
+							Computed: true,
+						},
+						"summary": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"status_category": schema.StringAttribute{
+							Computed:    true,
+							Description: "new, indeterminate or done, derived from the color of the status",
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"priority": schema.StringAttribute{
+							Computed: true,
+						},
+						"reporter": schema.StringAttribute{
+							Computed: true,
+						},
+						"created": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// The connected tickets endpoint only returns the color of the status, which
+// Jira derives from the status category.
+func ticketStatusCategory(colorName string) string {
+	switch colorName {
+	case "green":
+		return "done"
+	case "yellow":
+		return "indeterminate"
+	default:
+		return "new"
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectConnectedTicketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectConnectedTicketsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, _, err := d.client.Object.Relation(ctx, d.workspace_id, state.ObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object connected tickets",
+			"Could not read object connected tickets, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.AllTicketsQuery = types.StringValue(page.AllTicketsQuery)
+	state.Tickets = []connectedTicketModel{}
+	openTicketsExist := false
+	for _, ticket := range page.Tickets {
+		status, category, ticketType, priority := "", "new", "", ""
+		if ticket.Status != nil {
+			status = ticket.Status.Name
+			category = ticketStatusCategory(ticket.Status.ColorName)
+		}
+		if ticket.Type != nil {
+			ticketType = ticket.Type.Name
+		}
+		if ticket.Priority != nil {
+			priority = ticket.Priority.Name
+		}
+
+		if category != "done" {
+			openTicketsExist = true
+		}
+		if !state.StatusCategory.IsNull() && category != state.StatusCategory.ValueString() {
+			continue
+		}
+
+		state.Tickets = append(state.Tickets, connectedTicketModel{
+			Id:             types.StringValue(ticket.Id),
+			Key:            types.StringValue(ticket.Key),
+			Summary:        types.StringValue(ticket.Title),
+			Status:         types.StringValue(status),
+			StatusCategory: types.StringValue(category),
+			Type:           types.StringValue(ticketType),
+			Priority:       types.StringValue(priority),
+			Reporter:       types.StringValue(ticket.Reporter),
+			Created:        types.StringValue(ticket.Created),
+			Updated:        types.StringValue(ticket.Updated),
+		})
+	}
+	state.OpenTicketsExist = types.BoolValue(openTicketsExist)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -7,13 +7,15 @@ import (
 	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &objectDataSource{}
-	_ datasource.DataSourceWithConfigure = &objectDataSource{}
+	_ datasource.DataSource                   = &objectDataSource{}
+	_ datasource.DataSourceWithConfigure      = &objectDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &objectDataSource{}
 )
 
 type objectDataSource struct {
@@ -32,10 +34,32 @@ type objectDataResourceModel struct {
 	Updated      types.String `tfsdk:"updated"`
 	HasAvatar    types.Bool   `tfsdk:"has_avatar"`
 	Attributes   types.Set    `tfsdk:"attributes"` //<<[]objectAttributeModel
+	Values       types.Map    `tfsdk:"values"`     //<<map[string]string
+	RawValues    types.Map    `tfsdk:"raw_values"` //<<map[string][]string
 	Links        types.Object `tfsdk:"links"`      //<<objectModel
 	Avatar       types.Object `tfsdk:"avatar"`     //<<avatarModel
 }
 
+// The assets_object data source adds lookup arguments to objectDataResourceModel.
+type objectLookupDataModel struct {
+	WorkspaceId    types.String `tfsdk:"workspace_id"`
+	GlobalId       types.String `tfsdk:"global_id"`
+	Id             types.String `tfsdk:"id"`
+	Label          types.String `tfsdk:"label"`
+	ObjectKey      types.String `tfsdk:"object_key"`
+	ObjectTypeId   types.String `tfsdk:"object_type_id"`
+	AttributeName  types.String `tfsdk:"attribute_name"`
+	AttributeValue types.String `tfsdk:"attribute_value"`
+	Created        types.String `tfsdk:"created"`
+	Updated        types.String `tfsdk:"updated"`
+	HasAvatar      types.Bool   `tfsdk:"has_avatar"`
+	Attributes     types.Set    `tfsdk:"attributes"` //<<[]objectAttributeModel
+	Values         types.Map    `tfsdk:"values"`     //<<map[string]string
+	RawValues      types.Map    `tfsdk:"raw_values"` //<<map[string][]string
+	Links          types.Object `tfsdk:"links"`      //<<objectModel
+	Avatar         types.Object `tfsdk:"avatar"`     //<<avatarModel
+}
+
 // NewObjectDataSource is a helper function to simplify the provider implementation.
 func NewObjectDataSource() datasource.DataSource {
 	return &objectDataSource{}
@@ -78,16 +102,19 @@ func (d *objectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed: true,
 			},
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The object id to operate on",
+				Optional:    true,
+				Computed:    true,
+				Description: "The object id to operate on. Exactly one of id, object_key, label or attribute_name must be set",
 			},
 			"label": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The name of the object. This value is fetched from the attribute that is currently marked as label for the object type of this object",
+				Description: "The name of the object. This value is fetched from the attribute that is currently marked as label for the object type of this object. Set it with object_type_id to look the object up by label",
 			},
 			"object_key": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The external identifier for this object",
+				Description: "The external identifier for this object. Set it to look the object up by key",
 			},
 			"avatar": schema.ObjectAttribute{
 				AttributeTypes: avatarAttrTypes(),
@@ -95,8 +122,17 @@ func (d *objectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Description:    "The object avatar is a custom image that represents an object. If the object has no avatar the icon for the object type will be used",
 			},
 			"object_type_id": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The Assets object type",
+				Description: "The Assets object type. Required to look the object up by label or attribute_name",
+			},
+			"attribute_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of an attribute of object_type_id to look the object up by, with attribute_value",
+			},
+			"attribute_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "The value of attribute_name identifying the object",
 			},
 			"created": schema.StringAttribute{
 				Computed: true,
@@ -113,6 +149,18 @@ func (d *objectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					AttrTypes: objectAttributeAttrTypes(),
 				},
 			},
+			"values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The display values of the object attributes, keyed by attribute name. Multiple values are joined with \", \"",
+			},
+			"raw_values": schema.MapAttribute{
+				Computed: true,
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Description: "The raw values of the object attributes, keyed by attribute name",
+			},
 			"links": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -125,17 +173,119 @@ func (d *objectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	}
 }
 
+// ValidateConfig checks that exactly one lookup is configured.
+func (d *objectDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config objectLookupDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked once known
+	for _, value := range []types.String{config.Id, config.ObjectKey, config.ObjectTypeId, config.Label, config.AttributeName, config.AttributeValue} {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	lookups := 0
+	for _, value := range []types.String{config.Id, config.ObjectKey, config.Label, config.AttributeName} {
+		if !value.IsNull() {
+			lookups++
+		}
+	}
+
+	if lookups != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid object lookup",
+			"Exactly one of id, object_key, label or attribute_name must be set.",
+		)
+		return
+	}
+
+	if (!config.Label.IsNull() || !config.AttributeName.IsNull()) && config.ObjectTypeId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_type_id"),
+			"Invalid object lookup",
+			"object_type_id must be set to look an object up by label or attribute_name.",
+		)
+	}
+
+	if config.AttributeName.IsNull() != config.AttributeValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attribute_value"),
+			"Invalid object lookup",
+			"attribute_name and attribute_value must be set together.",
+		)
+	}
+}
+
+// Resolves the configured lookup to an object id through AQL.
+func (d *objectDataSource) lookupObjectId(ctx context.Context, config objectLookupDataModel) (string, error) {
+	if !config.Id.IsNull() {
+		return config.Id.ValueString(), nil
+	}
+
+	var aql string
+	switch {
+	case !config.ObjectKey.IsNull():
+		aql = "Key = " + aqlQuote(config.ObjectKey.ValueString())
+	case !config.Label.IsNull():
+		attributes, _, err := d.client.ObjectType.Attributes(ctx, d.workspace_id, config.ObjectTypeId.ValueString(), nil)
+		if err != nil {
+			return "", err
+		}
+		labelName := ""
+		for _, attribute := range attributes {
+			if attribute.Label {
+				labelName = attribute.Name
+				break
+			}
+		}
+		if labelName == "" {
+			return "", fmt.Errorf("objecttype %s has no label attribute", config.ObjectTypeId.ValueString())
+		}
+		aql = "objectTypeId = " + config.ObjectTypeId.ValueString() + " AND " + aqlQuote(labelName) + " = " + aqlQuote(config.Label.ValueString())
+	default:
+		aql = "objectTypeId = " + config.ObjectTypeId.ValueString() + " AND " + aqlQuote(config.AttributeName.ValueString()) + " = " + aqlQuote(config.AttributeValue.ValueString())
+	}
+
+	objects, err := searchObjects(ctx, d.client, d.workspace_id, aql, false)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(objects) {
+	case 0:
+		return "", fmt.Errorf("no object matches %s", aql)
+	case 1:
+		return objects[0].ID, nil
+	default:
+		return "", fmt.Errorf("%d objects match %s, the lookup must identify a single object", len(objects), aql)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *objectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get current state
-	var state objectDataResourceModel
-	diags := req.Config.Get(ctx, &state)
+	var config objectLookupDataModel
+	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	object, _, err := d.client.Object.Get(ctx, d.workspace_id, state.Id.ValueString())
+	id, err := d.lookupObjectId(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object",
+			"Could not find object, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	object, _, err := d.client.Object.Get(ctx, d.workspace_id, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading object",
@@ -144,7 +294,27 @@ func (d *objectDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	FillInformationsForDataObject(ctx, &state, object)
+	var data objectDataResourceModel
+	FillInformationsForDataObject(ctx, &data, object)
+
+	state := objectLookupDataModel{
+		WorkspaceId:    data.WorkspaceId,
+		GlobalId:       data.GlobalId,
+		Id:             data.Id,
+		Label:          data.Label,
+		ObjectKey:      data.ObjectKey,
+		ObjectTypeId:   data.ObjectTypeId,
+		AttributeName:  config.AttributeName,
+		AttributeValue: config.AttributeValue,
+		Created:        data.Created,
+		Updated:        data.Updated,
+		HasAvatar:      data.HasAvatar,
+		Attributes:     data.Attributes,
+		Values:         data.Values,
+		RawValues:      data.RawValues,
+		Links:          data.Links,
+		Avatar:         data.Avatar,
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectHistoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectHistoryDataSource{}
+)
+
+type objectHistoryDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectHistoryDataSourceModel struct {
+	ObjectId      types.String              `tfsdk:"object_id"`
+	From          types.String              `tfsdk:"from"`
+	To            types.String              `tfsdk:"to"`
+	AttributeName types.String              `tfsdk:"attribute_name"`
+	Entries       []objectHistoryEntryModel `tfsdk:"entries"`
+}
+
+type objectHistoryEntryModel struct {
+	Id                types.String `tfsdk:"id"`
+	Type              types.Int64  `tfsdk:"type"`
+	ActorName         types.String `tfsdk:"actor_name"`
+	ActorDisplayName  types.String `tfsdk:"actor_display_name"`
+	ActorEmail        types.String `tfsdk:"actor_email"`
+	AffectedAttribute types.String `tfsdk:"affected_attribute"`
+	OldValue          types.String `tfsdk:"old_value"`
+	NewValue          types.String `tfsdk:"new_value"`
+	Created           types.String `tfsdk:"created"`
+}
+
+// NewObjectHistoryDataSource is a helper function to simplify the provider implementation.
+func NewObjectHistoryDataSource() datasource.DataSource {
+	return &objectHistoryDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_history"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectHistoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectHistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The object id",
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the entries created at or after this RFC 3339 timestamp",
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the entries created before this RFC 3339 timestamp",
+			},
+			"attribute_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the entries affecting this attribute",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The history entries, oldest first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The kind of change, as returned by the Assets API",
+						},
+						"actor_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"actor_display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"actor_email": schema.StringAttribute{
+							Computed: true,
+						},
+						"affected_attribute": schema.StringAttribute{
+							Computed: true,
+						},
+						"old_value": schema.StringAttribute{
+							Computed: true,
+						},
+						"new_value": schema.StringAttribute{
+							Computed: true,
+						},
+						"created": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseHistoryBound(value types.String) (time.Time, error) {
+	if value.IsNull() {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value.ValueString())
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectHistoryDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, err := parseHistoryBound(state.From)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid from",
+			"Could not parse from, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	to, err := parseHistoryBound(state.To)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid to",
+			"Could not parse to, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	history, _, err := d.client.Object.History(ctx, d.workspace_id, state.ObjectId.ValueString(), true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object history",
+			"Could not read object history, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Entries = []objectHistoryEntryModel{}
+	for _, entry := range history {
+		if !state.AttributeName.IsNull() && entry.AffectedAttribute != state.AttributeName.ValueString() {
+			continue
+		}
+
+		if !from.IsZero() || !to.IsZero() {
+			created, err := time.Parse(time.RFC3339, entry.Created)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading object history",
+					"Could not parse the date of history entry "+entry.ID+", unexpected error: "+err.Error(),
+				)
+				return
+			}
+			if (!from.IsZero() && created.Before(from)) || (!to.IsZero() && !created.Before(to)) {
+				continue
+			}
+		}
+
+		historyEntry := objectHistoryEntryModel{
+			Id:                types.StringValue(entry.ID),
+			Type:              types.Int64Value(int64(entry.Type)),
+			ActorName:         types.StringValue(""),
+			ActorDisplayName:  types.StringValue(""),
+			ActorEmail:        types.StringValue(""),
+			AffectedAttribute: types.StringValue(entry.AffectedAttribute),
+			OldValue:          types.StringValue(entry.OldValue),
+			NewValue:          types.StringValue(entry.NewValue),
+			Created:           types.StringValue(entry.Created),
+		}
+		if entry.Actor != nil {
+			historyEntry.ActorName = types.StringValue(entry.Actor.Name)
+			historyEntry.ActorDisplayName = types.StringValue(entry.Actor.DisplayName)
+			historyEntry.ActorEmail = types.StringValue(entry.Actor.EmailAddress)
+		}
+		state.Entries = append(state.Entries, historyEntry)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
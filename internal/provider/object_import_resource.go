@@ -0,0 +1,662 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &objectImportResource{}
+	_ resource.ResourceWithConfigure  = &objectImportResource{}
+	_ resource.ResourceWithModifyPlan = &objectImportResource{}
+)
+
+// NewObjectImportResource is a helper function to simplify the provider implementation.
+func NewObjectImportResource() resource.Resource {
+	return &objectImportResource{}
+}
+
+// objectImportResource is the resource implementation.
+type objectImportResource struct {
+	client       *assets.Client
+	workspace_id string
+	features     *features
+}
+
+type objectImportResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	Format        types.String `tfsdk:"format"`
+	ObjectTypeId  types.String `tfsdk:"object_type_id"`
+	KeyColumn     types.String `tfsdk:"key_column"`
+	ColumnMapping types.Map    `tfsdk:"column_mapping"` //<<map[string]string
+	FileHash      types.String `tfsdk:"file_hash"`
+	ObjectIds     types.Map    `tfsdk:"object_ids"` //<<map[string]string
+	RowErrors     types.List   `tfsdk:"row_errors"` //<<[]string
+	InSync        types.Bool   `tfsdk:"in_sync"`
+}
+
+// A row of the imported file, with the line it starts on. Error describes a
+// malformed row, which is reported instead of imported.
+type importRow struct {
+	Line   int
+	Values map[string]string
+	Error  string
+}
+
+// The rows of the file matched against the live objects.
+type importDiff struct {
+	KeyAttributeId string
+	Tasks          []objectsTask
+	Lines          map[string]int
+	ObjectIds      map[string]string
+	RowErrors      []string
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectImportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+	r.features = assetsClient.Features
+}
+
+// Metadata returns the resource type name.
+func (r *objectImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_import"
+}
+
+// Schema defines the schema for the resource.
+func (r *objectImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the local CSV or JSON file. A JSON file must contain an array of objects",
+			},
+			"format": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("csv", "json"),
+				},
+				Description: "The format of the file, csv or json. Defaults to the extension of the file",
+			},
+			"object_type_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object type of the imported objects",
+			},
+			"key_column": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The column identifying an object. It must be part of column_mapping",
+			},
+			"column_mapping": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The name of the object type attribute to fill, keyed by column",
+			},
+			"file_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 of the file at the last apply",
+			},
+			"object_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of each imported object, keyed by the value of the key column",
+			},
+			"row_errors": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The rows that could not be imported at the last apply, with their line number",
+			},
+			"in_sync": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the objects matched the file at the last refresh. An apply is planned when they don't. Rows listed in row_errors for their content don't count",
+			},
+		},
+	}
+}
+
+func importFormat(importPath, format types.String) string {
+	if !format.IsNull() && !format.IsUnknown() {
+		return format.ValueString()
+	}
+	if strings.EqualFold(filepath.Ext(importPath.ValueString()), ".json") {
+		return "json"
+	}
+	return "csv"
+}
+
+func hashImportFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Reads the rows of the file, keeping the line each of them starts on.
+func readImportFile(path, format string) ([]importRow, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "json" {
+		return readImportJSON(content)
+	}
+	return readImportCSV(content)
+}
+
+func readImportCSV(content []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	// Rows with a wrong number of fields are reported one by one
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the CSV header: %w", err)
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := importRow{Line: line, Values: make(map[string]string, len(header))}
+		if len(record) != len(header) {
+			row.Error = fmt.Sprintf("expected %d fields, got %d", len(header), len(record))
+		}
+		for index, column := range header {
+			if index < len(record) {
+				row.Values[column] = record[index]
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+func readImportJSON(content []byte) ([]importRow, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return nil, fmt.Errorf("the JSON file must contain an array of objects")
+	}
+
+	var rows []importRow
+	for decoder.More() {
+		offset := int(decoder.InputOffset())
+		for offset < len(content) && strings.ContainsRune(" \t\r\n,", rune(content[offset])) {
+			offset++
+		}
+		line := bytes.Count(content[:offset], []byte("\n")) + 1
+
+		var values map[string]interface{}
+		if err := decoder.Decode(&values); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row := importRow{Line: line, Values: make(map[string]string, len(values))}
+		for column, value := range values {
+			if value != nil {
+				row.Values[column] = fmt.Sprint(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// Resolves the attribute names of the mapping to object type attribute ids.
+func (r *objectImportResource) attributeIds(ctx context.Context, objectTypeId string, mapping map[string]string) (map[string]string, error) {
+	attributes, _, err := r.client.ObjectType.Attributes(ctx, r.workspace_id, objectTypeId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string, len(mapping))
+	for column, name := range mapping {
+		for _, attribute := range attributes {
+			if attribute.Name == name {
+				ids[column] = attribute.ID
+				break
+			}
+		}
+		if _, ok := ids[column]; !ok {
+			return nil, fmt.Errorf("attribute %q of column %q not found in objecttype %s", name, column, objectTypeId)
+		}
+	}
+
+	return ids, nil
+}
+
+// Matches the rows of the file against the live objects of the type.
+func (r *objectImportResource) diff(ctx context.Context, model objectImportResourceModel, previousIds map[string]string) (*importDiff, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var mapping map[string]string
+	diags = model.ColumnMapping.ElementsAs(ctx, &mapping, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if _, ok := mapping[model.KeyColumn.ValueString()]; !ok {
+		diags.AddError(
+			"Invalid key_column",
+			fmt.Sprintf("The key column %q must be part of column_mapping.", model.KeyColumn.ValueString()),
+		)
+		return nil, diags
+	}
+
+	rows, err := readImportFile(model.Path.ValueString(), importFormat(model.Path, model.Format))
+	if err != nil {
+		diags.AddError(
+			"Error Reading import file",
+			"Could not read "+model.Path.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	attributeIds, err := r.attributeIds(ctx, model.ObjectTypeId.ValueString(), mapping)
+	if err != nil {
+		diags.AddError(
+			"Error Reading objecttypeattributes",
+			"Could not resolve column_mapping, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+	keyAttributeId := attributeIds[model.KeyColumn.ValueString()]
+
+	live, err := liveObjectsByKey(ctx, r.client, r.workspace_id, model.ObjectTypeId.ValueString(), keyAttributeId)
+	if err != nil {
+		diags.AddError(
+			"Error Reading objects",
+			"Could not read objects, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	diff := &importDiff{
+		KeyAttributeId: keyAttributeId,
+		Lines:          map[string]int{},
+		ObjectIds:      map[string]string{},
+	}
+
+	for _, row := range rows {
+		key := row.Values[model.KeyColumn.ValueString()]
+		if key == "" {
+			diff.RowErrors = append(diff.RowErrors, fmt.Sprintf("line %d: empty value in key column %q", row.Line, model.KeyColumn.ValueString()))
+			continue
+		}
+		if line, ok := diff.Lines[key]; ok {
+			diff.RowErrors = append(diff.RowErrors, fmt.Sprintf("line %d: duplicate key %q, already defined on line %d", row.Line, key, line))
+			continue
+		}
+		diff.Lines[key] = row.Line
+
+		// The object of a malformed row is left as it is
+		if row.Error != "" {
+			diff.RowErrors = append(diff.RowErrors, fmt.Sprintf("line %d: %s", row.Line, row.Error))
+			if object, ok := live[key]; ok {
+				diff.ObjectIds[key] = object.ID
+			}
+			continue
+		}
+
+		values := make(map[string]string, len(mapping))
+		for column, attributeId := range attributeIds {
+			value, ok := row.Values[column]
+			if ok && attributeId != keyAttributeId {
+				values[attributeId] = value
+			}
+		}
+
+		object, ok := live[key]
+		if !ok {
+			diff.Tasks = append(diff.Tasks, objectsTask{Key: key, Action: objectsChangeCreate, Values: values})
+			continue
+		}
+
+		diff.ObjectIds[key] = object.ID
+		if !objectValuesEqual(liveObjectValues(object, values), values) {
+			diff.Tasks = append(diff.Tasks, objectsTask{Key: key, Action: objectsChangeUpdate, ObjectId: object.ID, Values: values})
+		}
+	}
+
+	// Objects imported previously but no longer in the file are retired.
+	for key, id := range previousIds {
+		if _, ok := diff.Lines[key]; ok {
+			continue
+		}
+		if _, ok := live[key]; ok {
+			diff.Tasks = append(diff.Tasks, objectsTask{Key: key, Action: objectsChangeDelete, ObjectId: id})
+		}
+	}
+
+	sort.Slice(diff.Tasks, func(i, j int) bool {
+		return diff.Lines[diff.Tasks[i].Key] < diff.Lines[diff.Tasks[j].Key]
+	})
+
+	return diff, diags
+}
+
+// ModifyPlan plans an import whenever the file, the configuration or the
+// objects changed since the last apply.
+func (r *objectImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan objectImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Path.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		return
+	}
+
+	hash, err := hashImportFile(plan.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Error Reading import file",
+			"Could not read "+plan.Path.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Format = types.StringValue(importFormat(plan.Path, plan.Format))
+	plan.FileHash = types.StringValue(hash)
+
+	unchanged := false
+	if !req.State.Raw.IsNull() {
+		var state objectImportResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		unchanged = state.FileHash.Equal(plan.FileHash) &&
+			state.Path.Equal(plan.Path) &&
+			state.Format.Equal(plan.Format) &&
+			state.ObjectTypeId.Equal(plan.ObjectTypeId) &&
+			state.KeyColumn.Equal(plan.KeyColumn) &&
+			state.ColumnMapping.Equal(plan.ColumnMapping) &&
+			state.InSync.ValueBool()
+
+		// The id follows the path of the file
+		if !state.Path.Equal(plan.Path) {
+			plan.Id = types.StringUnknown()
+		}
+
+		if unchanged {
+			plan.ObjectIds = state.ObjectIds
+			plan.RowErrors = state.RowErrors
+			plan.InSync = state.InSync
+		}
+	}
+
+	if !unchanged {
+		plan.ObjectIds = types.MapUnknown(types.StringType)
+		plan.RowErrors = types.ListUnknown(types.StringType)
+		plan.InSync = types.BoolUnknown()
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Imports the file and updates the plan with the result. Failing rows are
+// reported as warnings with their line number and do not stop the import.
+func (r *objectImportResource) apply(ctx context.Context, plan *objectImportResourceModel, previousIds map[string]string) diag.Diagnostics {
+	diff, diags := r.diff(ctx, *plan, previousIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	results := runObjectsTasks(ctx, r.client, r.workspace_id, r.features, plan.ObjectTypeId.ValueString(), diff.KeyAttributeId, defaultObjectsMaxWorkers, diff.Tasks)
+
+	// Malformed rows stay in error until the file is fixed, only the failed
+	// API calls leave the objects out of sync
+	ids := diff.ObjectIds
+	rowErrors := diff.RowErrors
+	failed := 0
+	for _, result := range results {
+		key := result.Task.Key
+		if result.Err == nil {
+			if result.Task.Action == objectsChangeCreate {
+				ids[key] = result.ObjectId
+			}
+			continue
+		}
+
+		failed++
+		if result.Task.Action == objectsChangeDelete {
+			ids[key] = result.ObjectId
+			rowErrors = append(rowErrors, fmt.Sprintf("key %q: could not retire object %s: %s", key, result.ObjectId, result.Err.Error()))
+			continue
+		}
+		rowErrors = append(rowErrors, fmt.Sprintf("line %d: could not %s object %q: %s", diff.Lines[key], result.Task.Action, key, result.Err.Error()))
+	}
+
+	for _, rowError := range rowErrors {
+		diags.AddWarning("Error importing row", rowError)
+	}
+
+	var d diag.Diagnostics
+	plan.Id = plan.Path
+	plan.ObjectIds, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	plan.RowErrors, d = types.ListValueFrom(ctx, types.StringType, rowErrors)
+	diags.Append(d...)
+	plan.InSync = types.BoolValue(failed == 0)
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *objectImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan objectImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan, map[string]string{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *objectImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state objectImportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]string{}
+	diags = state.ObjectIds.ElementsAs(ctx, &ids, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A file that can no longer be read is reported when planning.
+	if _, err := os.Stat(state.Path.ValueString()); err != nil {
+		return
+	}
+
+	diff, diags := r.diff(ctx, state, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Objects removed from the file are kept until they are retired, so the
+	// next apply still knows them.
+	for _, task := range diff.Tasks {
+		if task.Action == objectsChangeDelete {
+			diff.ObjectIds[task.Key] = task.ObjectId
+		}
+	}
+
+	state.InSync = types.BoolValue(state.InSync.ValueBool() && len(diff.Tasks) == 0 && len(diff.ObjectIds) == len(ids))
+	state.ObjectIds, diags = types.MapValueFrom(ctx, types.StringType, diff.ObjectIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *objectImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan objectImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state objectImportResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]string{}
+	diags = state.ObjectIds.ElementsAs(ctx, &ids, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete retires every imported object and removes the Terraform state on success.
+func (r *objectImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state objectImportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]string{}
+	diags = state.ObjectIds.ElementsAs(ctx, &ids, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tasks := make([]objectsTask, 0, len(ids))
+	for key, id := range ids {
+		tasks = append(tasks, objectsTask{Key: key, Action: objectsChangeDelete, ObjectId: id})
+	}
+
+	for _, result := range runObjectsTasks(ctx, r.client, r.workspace_id, r.features, state.ObjectTypeId.ValueString(), "", defaultObjectsMaxWorkers, tasks) {
+		if result.Err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error Deleting object %q", result.Task.Key),
+				"Could not delete object, unexpected error: "+result.Err.Error(),
+			)
+		}
+	}
+}
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &objectReferenceResource{}
+	_ resource.ResourceWithConfigure   = &objectReferenceResource{}
+	_ resource.ResourceWithImportState = &objectReferenceResource{}
+)
+
+// References of the same object are read, modified and written back as a
+// whole, so concurrent changes on one object must be serialized.
+var (
+	objectReferenceLocksMutex sync.Mutex
+	objectReferenceLocks      = map[string]*sync.Mutex{}
+)
+
+func lockObjectReferences(objectId string) func() {
+	objectReferenceLocksMutex.Lock()
+	lock, ok := objectReferenceLocks[objectId]
+	if !ok {
+		lock = &sync.Mutex{}
+		objectReferenceLocks[objectId] = lock
+	}
+	objectReferenceLocksMutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// NewObjectReferenceResource is a helper function to simplify the provider implementation.
+func NewObjectReferenceResource() resource.Resource {
+	return &objectReferenceResource{}
+}
+
+// objectReferenceResource is the resource implementation.
+type objectReferenceResource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectReferenceResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	ObjectId              types.String `tfsdk:"object_id"`
+	ObjectTypeId          types.String `tfsdk:"object_type_id"`
+	ObjectTypeAttributeId types.String `tfsdk:"object_type_attribute_id"`
+	ReferencedObjectId    types.String `tfsdk:"referenced_object_id"`
+	ReferencedObjectKey   types.String `tfsdk:"referenced_object_key"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectReferenceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Metadata returns the resource type name.
+func (r *objectReferenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_reference"
+}
+
+// Schema defines the schema for the resource.
+func (r *objectReferenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The identifier of the reference, in the form object_id/object_type_attribute_id/referenced_object_id",
+			},
+			"object_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The id of the object holding the reference attribute",
+			},
+			"object_type_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The object type of the object holding the reference attribute",
+			},
+			"object_type_attribute_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The id of the reference attribute",
+			},
+			"referenced_object_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The id of the object to reference",
+			},
+			"referenced_object_key": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The external identifier of the referenced object",
+			},
+		},
+	}
+}
+
+// Returns every referenced object id currently stored in the attribute, and the
+// referenced object matching referencedObjectId if it is one of them.
+func findObjectReferences(attributes []*objectAttributeReferenceScheme, objectTypeAttributeId, referencedObjectId string) ([]string, *referencedObjectScheme, error) {
+	var ids []string
+	var found *referencedObjectScheme
+
+	for _, attribute := range attributes {
+		if attribute.ObjectTypeAttributeId != objectTypeAttributeId {
+			continue
+		}
+
+		if attribute.ObjectTypeAttribute != nil && attribute.ObjectTypeAttribute.Type != 1 {
+			return nil, nil, fmt.Errorf("objecttypeattribute %s is not a reference attribute", objectTypeAttributeId)
+		}
+
+		for _, value := range attribute.ObjectAttributeValues {
+			// Values whose referenced object isn't expanded are kept as they
+			// are, they are written back with the attribute.
+			if value.ReferencedObject == nil {
+				id := value.Value
+				if id == "" {
+					id = value.SearchValue
+				}
+				if id != "" {
+					ids = append(ids, id)
+				}
+				continue
+			}
+			ids = append(ids, value.ReferencedObject.ID)
+			if value.ReferencedObject.ID == referencedObjectId {
+				found = value.ReferencedObject
+			}
+		}
+	}
+
+	return ids, found, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *objectReferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan objectReferenceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := lockObjectReferences(plan.ObjectId.ValueString())
+	defer unlock()
+
+	object, _, err := r.client.Object.Get(ctx, r.workspace_id, plan.ObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object",
+			"Could not read object, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	attributes, _, err := getObjectAttributesWithReferences(ctx, r.client, r.workspace_id, plan.ObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object attributes",
+			"Could not read object attributes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ids, found, err := findObjectReferences(attributes, plan.ObjectTypeAttributeId.ValueString(), plan.ReferencedObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating object reference",
+			"Could not create object reference, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The reference may already exist, in which case it is simply adopted.
+	if found == nil {
+		ids = append(ids, plan.ReferencedObjectId.ValueString())
+
+		_, err = setObjectAttributeValues(ctx, r.client, r.workspace_id, object.ObjectType.Id, plan.ObjectId.ValueString(), plan.ObjectTypeAttributeId.ValueString(), ids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating object reference",
+				"Could not create object reference, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		attributes, _, err = getObjectAttributesWithReferences(ctx, r.client, r.workspace_id, plan.ObjectId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading object attributes",
+				"Could not read object attributes, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		_, found, err = findObjectReferences(attributes, plan.ObjectTypeAttributeId.ValueString(), plan.ReferencedObjectId.ValueString())
+		if err != nil || found == nil {
+			resp.Diagnostics.AddError(
+				"Error creating object reference",
+				"Could not create object reference, the reference is missing after the update.",
+			)
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(strings.Join([]string{plan.ObjectId.ValueString(), plan.ObjectTypeAttributeId.ValueString(), plan.ReferencedObjectId.ValueString()}, "/"))
+	plan.ObjectTypeId = types.StringValue(object.ObjectType.Id)
+	plan.ReferencedObjectKey = types.StringValue(found.ObjectKey)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *objectReferenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state objectReferenceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ObjectTypeId.IsNull() || state.ObjectTypeId.IsUnknown() {
+		object, response, err := r.client.Object.Get(ctx, r.workspace_id, state.ObjectId.ValueString())
+		if err != nil {
+			if response == nil || response.Code != 404 {
+				resp.Diagnostics.AddError(
+					"Error Reading object",
+					"Could not read object, unexpected error: "+err.Error(),
+				)
+			} else {
+				resp.State.RemoveResource(ctx)
+			}
+			return
+		}
+		state.ObjectTypeId = types.StringValue(object.ObjectType.Id)
+	}
+
+	attributes, response, err := getObjectAttributesWithReferences(ctx, r.client, r.workspace_id, state.ObjectId.ValueString())
+	if err != nil {
+		if response == nil || response.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Error Reading object attributes",
+				"Could not read object attributes, unexpected error: "+err.Error(),
+			)
+		} else {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	_, found, err := findObjectReferences(attributes, state.ObjectTypeAttributeId.ValueString(), state.ReferencedObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object reference",
+			"Could not read object reference, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The value has been removed outside of Terraform.
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ReferencedObjectKey = types.StringValue(found.ObjectKey)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every configurable attribute requires a replacement, so there is nothing to send.
+func (r *objectReferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan objectReferenceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *objectReferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state objectReferenceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unlock := lockObjectReferences(state.ObjectId.ValueString())
+	defer unlock()
+
+	attributes, response, err := getObjectAttributesWithReferences(ctx, r.client, r.workspace_id, state.ObjectId.ValueString())
+	if err != nil {
+		if response == nil || response.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Error Reading object attributes",
+				"Could not read object attributes, unexpected error: "+err.Error(),
+			)
+		}
+		return
+	}
+
+	ids, found, err := findObjectReferences(attributes, state.ObjectTypeAttributeId.ValueString(), state.ReferencedObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting object reference",
+			"Could not delete object reference, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if found == nil {
+		return
+	}
+
+	remaining := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != state.ReferencedObjectId.ValueString() {
+			remaining = append(remaining, id)
+		}
+	}
+
+	_, err = setObjectAttributeValues(ctx, r.client, r.workspace_id, state.ObjectTypeId.ValueString(), state.ObjectId.ValueString(), state.ObjectTypeAttributeId.ValueString(), remaining)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting object reference",
+			"Could not delete object reference, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *objectReferenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: object_id/object_type_attribute_id/referenced_object_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type_attribute_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("referenced_object_id"), parts[2])...)
+}
@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectReferencesDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectReferencesDataSource{}
+)
+
+type objectReferencesDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectReferencesDataSourceModel struct {
+	ObjectId      types.String               `tfsdk:"object_id"`
+	Direction     types.String               `tfsdk:"direction"`
+	Depth         types.Int64                `tfsdk:"depth"`
+	ReferenceInfo []objectReferenceInfoModel `tfsdk:"reference_info"`
+	References    []objectReferenceEdgeModel `tfsdk:"references"`
+}
+
+type objectReferenceInfoModel struct {
+	ObjectTypeId              types.String `tfsdk:"object_type_id"`
+	ObjectTypeName            types.String `tfsdk:"object_type_name"`
+	ReferenceTypes            types.List   `tfsdk:"reference_types"` //<<[]string
+	NumberOfReferencedObjects types.Int64  `tfsdk:"number_of_referenced_objects"`
+	OpenIssuesExists          types.Bool   `tfsdk:"open_issues_exists"`
+}
+
+type objectReferenceEdgeModel struct {
+	Direction     types.String `tfsdk:"direction"`
+	Depth         types.Int64  `tfsdk:"depth"`
+	ObjectId      types.String `tfsdk:"object_id"`
+	ObjectKey     types.String `tfsdk:"object_key"`
+	ObjectLabel   types.String `tfsdk:"object_label"`
+	AttributeId   types.String `tfsdk:"attribute_id"`
+	AttributeName types.String `tfsdk:"attribute_name"`
+	ReferenceType types.String `tfsdk:"reference_type"`
+	FromObjectId  types.String `tfsdk:"from_object_id"`
+	ToObjectId    types.String `tfsdk:"to_object_id"`
+}
+
+// NewObjectReferencesDataSource is a helper function to simplify the provider implementation.
+func NewObjectReferencesDataSource() datasource.DataSource {
+	return &objectReferencesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectReferencesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_references"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectReferencesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectReferencesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The object id",
+			},
+			"direction": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("inbound", "outbound", "both"),
+				},
+				Description: "The references to follow: inbound (objects referencing this object), outbound (objects referenced by this object) or both. Defaults to both",
+			},
+			"depth": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+				Description: "How many levels of references to follow. Defaults to 1",
+			},
+			"reference_info": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The summary of the references of the object by object type, as returned by the reference info endpoint",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_type_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"object_type_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"reference_types": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"number_of_referenced_objects": schema.Int64Attribute{
+							Computed: true,
+						},
+						"open_issues_exists": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"references": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The references found, breadth first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							Computed:    true,
+							Description: "inbound or outbound",
+						},
+						"depth": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The level of the reference, 1 for the references of object_id",
+						},
+						"object_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The linked object",
+						},
+						"object_key": schema.StringAttribute{
+							Computed: true,
+						},
+						"object_label": schema.StringAttribute{
+							Computed: true,
+						},
+						"attribute_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The reference attribute, defined on the object type of from_object_id",
+						},
+						"attribute_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"reference_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"from_object_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The object holding the reference attribute",
+						},
+						"to_object_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The referenced object",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Lists the outbound references of an object from its reference attributes.
+func (d *objectReferencesDataSource) outboundReferences(ctx context.Context, objectId string) ([]objectReferenceEdgeModel, error) {
+	attributes, _, err := getObjectAttributesWithReferences(ctx, d.client, d.workspace_id, objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var edges []objectReferenceEdgeModel
+	for _, attribute := range attributes {
+		if attribute.ObjectTypeAttribute == nil || attribute.ObjectTypeAttribute.Type != 1 {
+			continue
+		}
+
+		referenceType := ""
+		if attribute.ObjectTypeAttribute.ReferenceType != nil {
+			referenceType = attribute.ObjectTypeAttribute.ReferenceType.Name
+		}
+
+		for _, value := range attribute.ObjectAttributeValues {
+			if value.ReferencedObject == nil {
+				continue
+			}
+			edges = append(edges, objectReferenceEdgeModel{
+				Direction:     types.StringValue("outbound"),
+				ObjectId:      types.StringValue(value.ReferencedObject.ID),
+				ObjectKey:     types.StringValue(value.ReferencedObject.ObjectKey),
+				ObjectLabel:   types.StringValue(value.ReferencedObject.Label),
+				AttributeId:   types.StringValue(attribute.ObjectTypeAttributeId),
+				AttributeName: types.StringValue(attribute.ObjectTypeAttribute.Name),
+				ReferenceType: types.StringValue(referenceType),
+				FromObjectId:  types.StringValue(objectId),
+				ToObjectId:    types.StringValue(value.ReferencedObject.ID),
+			})
+		}
+	}
+
+	return edges, nil
+}
+
+// Lists the inbound references of an object: the objects referencing it are
+// found with AQL, then their reference attributes pointing to it.
+func (d *objectReferencesDataSource) inboundReferences(ctx context.Context, objectId string) ([]objectReferenceEdgeModel, error) {
+	objects, err := searchObjects(ctx, d.client, d.workspace_id, "object HAVING outboundReferences(objectId = "+objectId+")", false)
+	if err != nil {
+		return nil, err
+	}
+
+	var edges []objectReferenceEdgeModel
+	for _, object := range objects {
+		outbound, err := d.outboundReferences(ctx, object.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range outbound {
+			if edge.ToObjectId.ValueString() != objectId {
+				continue
+			}
+			edge.Direction = types.StringValue("inbound")
+			edge.ObjectId = types.StringValue(object.ID)
+			edge.ObjectKey = types.StringValue(object.ObjectKey)
+			edge.ObjectLabel = types.StringValue(object.Label)
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectReferencesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectReferencesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	direction := "both"
+	if !state.Direction.IsNull() {
+		direction = state.Direction.ValueString()
+	}
+	depth := int64(1)
+	if !state.Depth.IsNull() {
+		depth = state.Depth.ValueInt64()
+	}
+
+	referenceInfo, _, err := d.client.Object.References(ctx, d.workspace_id, state.ObjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading object references",
+			"Could not read object reference info, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.ReferenceInfo = []objectReferenceInfoModel{}
+	for _, info := range referenceInfo {
+		names := []string{}
+		for _, referenceType := range info.ReferenceTypes {
+			names = append(names, referenceType.Name)
+		}
+
+		infoModel := objectReferenceInfoModel{
+			ObjectTypeId:              types.StringValue(""),
+			ObjectTypeName:            types.StringValue(""),
+			NumberOfReferencedObjects: types.Int64Value(int64(info.NumberOfReferencedObjects)),
+			OpenIssuesExists:          types.BoolValue(info.OpenIssuesExists),
+		}
+		if info.ObjectType != nil {
+			infoModel.ObjectTypeId = types.StringValue(info.ObjectType.Id)
+			infoModel.ObjectTypeName = types.StringValue(info.ObjectType.Name)
+		}
+		infoModel.ReferenceTypes, diags = types.ListValueFrom(ctx, types.StringType, names)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ReferenceInfo = append(state.ReferenceInfo, infoModel)
+	}
+
+	// Breadth first traversal, each object is expanded once per direction
+	state.References = []objectReferenceEdgeModel{}
+	type node struct {
+		objectId  string
+		direction string
+	}
+	visited := map[node]bool{}
+	frontier := []node{}
+	for _, dir := range []string{"inbound", "outbound"} {
+		if direction == "both" || direction == dir {
+			frontier = append(frontier, node{state.ObjectId.ValueString(), dir})
+		}
+	}
+
+	for level := int64(1); level <= depth && len(frontier) > 0; level++ {
+		var next []node
+		for _, current := range frontier {
+			if visited[current] {
+				continue
+			}
+			visited[current] = true
+
+			var edges []objectReferenceEdgeModel
+			if current.direction == "inbound" {
+				edges, err = d.inboundReferences(ctx, current.objectId)
+			} else {
+				edges, err = d.outboundReferences(ctx, current.objectId)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading object references",
+					"Could not read the "+current.direction+" references of object "+current.objectId+", unexpected error: "+err.Error(),
+				)
+				return
+			}
+
+			for _, edge := range edges {
+				edge.Depth = types.Int64Value(level)
+				state.References = append(state.References, edge)
+				next = append(next, node{edge.ObjectId.ValueString(), current.direction})
+			}
+		}
+		frontier = next
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -53,6 +53,8 @@ type objectResourceModel struct {
 	Updated      types.String              `tfsdk:"updated"`
 	HasAvatar    types.Bool                `tfsdk:"has_avatar"`
 	Attributes   types.Set                 `tfsdk:"attributes"` //<<[]objectAttributeModel
+	Values       types.Map                 `tfsdk:"values"`     //<<map[string]string
+	RawValues    types.Map                 `tfsdk:"raw_values"` //<<map[string][]string
 	Links        types.Object              `tfsdk:"links"`      //<<objectModel
 	AttributesIn []*objectAttributeInModel `tfsdk:"attributes_in"`
 	Avatar       types.Object              `tfsdk:"avatar"` //<<avatarModel
@@ -240,6 +242,18 @@ func (r *objectResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					},
 				},
 			},
+			"values": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The display values of the object attributes, keyed by attribute name. Multiple values are joined with \", \"",
+			},
+			"raw_values": schema.MapAttribute{
+				Computed: true,
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Description: "The raw values of the object attributes, keyed by attribute name",
+			},
 			"attributes": schema.SetNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -550,21 +564,7 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if !r.features.DestroyObject {
-		payload := models.ObjectPayloadScheme{
-			Attributes: []*models.ObjectPayloadAttributeScheme{
-				{
-					ObjectTypeAttributeID: r.features.ObsoleteObjectTypeAttributeId,
-					ObjectAttributeValues: []*models.ObjectPayloadAttributeValueScheme{
-						{
-							Value: "Obsolete",
-						},
-					},
-				},
-			},
-			ObjectTypeID: state.ObjectTypeId.ValueString(),
-		}
-
-		_, _, err := r.client.Object.Update(ctx, r.workspace_id, state.Id.ValueString(), &payload)
+		err := obsoleteObject(ctx, r.client, r.workspace_id, r.features, state.ObjectTypeId.ValueString(), state.Id.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating object",
@@ -585,6 +585,54 @@ func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// Marks the object as obsolete instead of deleting it, see features.destroy_object.
+func obsoleteObject(ctx context.Context, client *assets.Client, workspaceId string, features *features, objectTypeId, objectId string) error {
+	payload := models.ObjectPayloadScheme{
+		Attributes: []*models.ObjectPayloadAttributeScheme{
+			{
+				ObjectTypeAttributeID: features.ObsoleteObjectTypeAttributeId,
+				ObjectAttributeValues: []*models.ObjectPayloadAttributeValueScheme{
+					{
+						Value: features.ObsoleteStatus,
+					},
+				},
+			},
+		},
+		ObjectTypeID: objectTypeId,
+	}
+
+	_, _, err := client.Object.Update(ctx, workspaceId, objectId, &payload)
+	return err
+}
+
+// Whether an object holds the obsolete status written by obsoleteObject.
+func isObsoleteObject(object *models.ObjectScheme, features *features) bool {
+	for _, attribute := range object.Attributes {
+		if attribute.ObjectTypeAttributeId != features.ObsoleteObjectTypeAttributeId {
+			continue
+		}
+		for _, value := range attribute.ObjectAttributeValues {
+			if value.Value == features.ObsoleteStatus || value.DisplayValue == features.ObsoleteStatus {
+				return true
+			}
+			if value.Status != nil && (value.Status.ID == features.ObsoleteStatus || value.Status.Name == features.ObsoleteStatus) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Deletes the object, or marks it as obsolete when features.destroy_object is false.
+func destroyObject(ctx context.Context, client *assets.Client, workspaceId string, features *features, objectTypeId, objectId string) error {
+	if !features.DestroyObject {
+		return obsoleteObject(ctx, client, workspaceId, features, objectTypeId, objectId)
+	}
+
+	_, err := client.Object.Delete(ctx, workspaceId, objectId)
+	return err
+}
+
 func (r *objectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
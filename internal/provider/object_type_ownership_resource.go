@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &objectTypeOwnershipResource{}
+	_ resource.ResourceWithConfigure   = &objectTypeOwnershipResource{}
+	_ resource.ResourceWithModifyPlan  = &objectTypeOwnershipResource{}
+	_ resource.ResourceWithImportState = &objectTypeOwnershipResource{}
+)
+
+// NewObjectTypeOwnershipResource is a helper function to simplify the provider implementation.
+func NewObjectTypeOwnershipResource() resource.Resource {
+	return &objectTypeOwnershipResource{}
+}
+
+// objectTypeOwnershipResource is the resource implementation.
+type objectTypeOwnershipResource struct {
+	client       *assets.Client
+	workspace_id string
+	features     *features
+}
+
+type objectTypeOwnershipResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	ObjectTypeId types.String `tfsdk:"object_type_id"`
+	AqlFilter    types.String `tfsdk:"aql_filter"`
+	ObjectIds    types.Set    `tfsdk:"object_ids"` //<<[]string
+	OrphanIds    types.Set    `tfsdk:"orphan_ids"` //<<[]string
+	InSync       types.Bool   `tfsdk:"in_sync"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectTypeOwnershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+	r.features = assetsClient.Features
+}
+
+// Metadata returns the resource type name.
+func (r *objectTypeOwnershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_type_ownership"
+}
+
+// Schema defines the schema for the resource.
+func (r *objectTypeOwnershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object type owned by Terraform",
+			},
+			"aql_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "An AQL query restricting the owned objects of the object type",
+			},
+			"object_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The ids of the objects declared in Terraform. The other owned objects are deleted, or made obsolete depending on features.destroy_object",
+			},
+			"orphan_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The ids of the owned objects not declared in object_ids at the last plan or refresh. They are pruned on apply",
+			},
+			"in_sync": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether there was no orphan object at the last refresh. An apply is planned when there are",
+			},
+		},
+	}
+}
+
+// Lists the owned objects that are not declared in object_ids. Objects
+// already obsolete are skipped when they are not destroyed.
+func (r *objectTypeOwnershipResource) orphans(ctx context.Context, model objectTypeOwnershipResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var declared []string
+	diags = model.ObjectIds.ElementsAs(ctx, &declared, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	declaredIds := make(map[string]bool, len(declared))
+	for _, id := range declared {
+		declaredIds[id] = true
+	}
+
+	aql := "objectTypeId = " + model.ObjectTypeId.ValueString()
+	if model.AqlFilter.ValueString() != "" {
+		aql += " AND (" + model.AqlFilter.ValueString() + ")"
+	}
+
+	objects, err := searchObjects(ctx, r.client, r.workspace_id, aql, !r.features.DestroyObject)
+	if err != nil {
+		diags.AddError(
+			"Error Reading objects",
+			"Could not search objects with AQL "+aql+", unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	orphans := []string{}
+	for _, object := range objects {
+		if declaredIds[object.ID] {
+			continue
+		}
+		if !r.features.DestroyObject && isObsoleteObject(object, r.features) {
+			continue
+		}
+		orphans = append(orphans, object.ID)
+	}
+	sort.Strings(orphans)
+
+	return orphans, diags
+}
+
+// Prunes the orphan objects shown in the plan and updates it. Objects which
+// became orphans after the plan are left for the next one, and an object that
+// can't be pruned is reported and found again at the next refresh. When the
+// orphans were unknown at plan time, only those of the prior state are pruned.
+func (r *objectTypeOwnershipResource) apply(ctx context.Context, plan *objectTypeOwnershipResourceModel, prior types.Set) diag.Diagnostics {
+	orphans, diags := r.orphans(ctx, *plan)
+	if diags.HasError() {
+		return diags
+	}
+
+	planned := plan.OrphanIds
+	if planned.IsUnknown() {
+		planned = prior
+	}
+
+	var expected []string
+	if !planned.IsNull() && !planned.IsUnknown() {
+		diags = planned.ElementsAs(ctx, &expected, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	expectedIds := make(map[string]bool, len(expected))
+	for _, id := range expected {
+		expectedIds[id] = true
+	}
+
+	pruned := []string{}
+	for _, id := range orphans {
+		if !expectedIds[id] {
+			continue
+		}
+		pruned = append(pruned, id)
+
+		err := destroyObject(ctx, r.client, r.workspace_id, r.features, plan.ObjectTypeId.ValueString(), id)
+		if err != nil {
+			diags.AddError(
+				"Error Deleting object",
+				"Could not prune object "+id+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+
+	plan.Id = plan.ObjectTypeId
+	plan.InSync = types.BoolValue(true)
+	if plan.OrphanIds.IsUnknown() {
+		var d diag.Diagnostics
+		plan.OrphanIds, d = types.SetValueFrom(ctx, types.StringType, pruned)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// ModifyPlan plans the removal of every orphan object. They are unknown until
+// the declared objects are.
+func (r *objectTypeOwnershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan objectTypeOwnershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := !plan.ObjectTypeId.IsUnknown() && !plan.AqlFilter.IsUnknown() && !plan.ObjectIds.IsUnknown() && !plan.ObjectIds.IsNull()
+	if known {
+		for _, element := range plan.ObjectIds.Elements() {
+			if element.IsUnknown() {
+				known = false
+			}
+		}
+	}
+
+	// The orphans can only be searched once the provider is configured
+	if r.client == nil || r.features == nil {
+		known = false
+	}
+
+	plan.InSync = types.BoolValue(true)
+	plan.OrphanIds = types.SetUnknown(types.StringType)
+	if known {
+		orphans, diags := r.orphans(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.OrphanIds, diags = types.SetValueFrom(ctx, types.StringType, orphans)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *objectTypeOwnershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan objectTypeOwnershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan, types.SetNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *objectTypeOwnershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state objectTypeOwnershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources declare no object yet
+	if state.ObjectIds.IsNull() {
+		state.ObjectIds = types.SetValueMust(types.StringType, nil)
+	}
+
+	orphans, diags := r.orphans(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.InSync = types.BoolValue(len(orphans) == 0)
+	state.OrphanIds, diags = types.SetValueFrom(ctx, types.StringType, orphans)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *objectTypeOwnershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan objectTypeOwnershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state objectTypeOwnershipResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan, state.OrphanIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the Terraform state, the objects are left untouched.
+func (r *objectTypeOwnershipResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *objectTypeOwnershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and object_type_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type_id"), req.ID)...)
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectsDataSource{}
+)
+
+type objectsDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectsDataSourceModel struct {
+	Aql               types.String `tfsdk:"aql"`
+	ObjectSchemaId    types.String `tfsdk:"object_schema_id"`
+	IncludeAttributes types.Bool   `tfsdk:"include_attributes"`
+	MaxResults        types.Int64  `tfsdk:"max_results"`
+	Total             types.Int64  `tfsdk:"total"`
+	Objects           types.List   `tfsdk:"objects"` //<<[]objectDataResourceModel
+}
+
+// NewObjectsDataSource is a helper function to simplify the provider implementation.
+func NewObjectsDataSource() datasource.DataSource {
+	return &objectsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objects"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aql": schema.StringAttribute{
+				Required:    true,
+				Description: "The AQL query selecting the objects",
+			},
+			"object_schema_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Restrict the search to an object schema",
+			},
+			"include_attributes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the attributes of the objects are returned. Defaults to true",
+			},
+			"max_results": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The maximum number of objects returned. All matching objects are returned when not set",
+			},
+			"total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of objects matching the query",
+			},
+			"objects": schema.ListAttribute{
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: objectDataAttrTypes(),
+				},
+				Description: "The matching objects, with the same attributes as the assets_object data source",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	const pageSize = 100
+	maxResults := int(state.MaxResults.ValueInt64())
+	includeAttributes := state.IncludeAttributes.IsNull() || state.IncludeAttributes.ValueBool()
+
+	var entries []*models.ObjectScheme
+	total := 0
+	for page := 1; ; page++ {
+		result, _, err := d.client.Object.Search(ctx, d.workspace_id, &models.ObjectSearchParamsScheme{
+			Query:             state.Aql.ValueString(),
+			ObjectSchemaID:    state.ObjectSchemaId.ValueString(),
+			Page:              page,
+			ResultPerPage:     pageSize,
+			IncludeAttributes: includeAttributes,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading objects",
+				"Could not search objects, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		total = result.TotalFilterCount
+		entries = append(entries, result.ObjectEntries...)
+
+		if len(result.ObjectEntries) < pageSize || len(entries) >= total || (maxResults > 0 && len(entries) >= maxResults) {
+			break
+		}
+	}
+
+	if maxResults > 0 && len(entries) > maxResults {
+		entries = entries[:maxResults]
+	}
+
+	objects := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		var object objectDataResourceModel
+		diags = FillInformationsForDataObject(ctx, &object, entry)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		value, diags := types.ObjectValueFrom(ctx, objectDataAttrTypes(), object)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		objects = append(objects, value)
+	}
+
+	state.Total = types.Int64Value(int64(total))
+	state.Objects, diags = types.ListValue(types.ObjectType{AttrTypes: objectDataAttrTypes()}, objects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func objectDataAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"workspace_id":   types.StringType,
+		"global_id":      types.StringType,
+		"id":             types.StringType,
+		"label":          types.StringType,
+		"object_key":     types.StringType,
+		"object_type_id": types.StringType,
+		"created":        types.StringType,
+		"updated":        types.StringType,
+		"has_avatar":     types.BoolType,
+		"attributes":     types.SetType{ElemType: types.ObjectType{AttrTypes: objectAttributeAttrTypes()}},
+		"values":         types.MapType{ElemType: types.StringType},
+		"raw_values":     types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+		"links":          types.ObjectType{AttrTypes: objectAttrTypes()},
+		"avatar":         types.ObjectType{AttrTypes: avatarAttrTypes()},
+	}
+}
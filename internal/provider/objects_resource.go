@@ -0,0 +1,670 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &objectsResource{}
+	_ resource.ResourceWithConfigure  = &objectsResource{}
+	_ resource.ResourceWithModifyPlan = &objectsResource{}
+)
+
+const (
+	objectsChangeCreate = "create"
+	objectsChangeUpdate = "update"
+	objectsChangeDelete = "delete"
+)
+
+// The number of objects created, updated or deleted concurrently unless
+// configured otherwise.
+const defaultObjectsMaxWorkers = 5
+
+// NewObjectsResource is a helper function to simplify the provider implementation.
+func NewObjectsResource() resource.Resource {
+	return &objectsResource{}
+}
+
+// objectsResource is the resource implementation.
+type objectsResource struct {
+	client       *assets.Client
+	workspace_id string
+	features     *features
+}
+
+type objectsResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ObjectTypeId   types.String `tfsdk:"object_type_id"`
+	KeyAttributeId types.String `tfsdk:"key_attribute_id"`
+	Objects        types.Map    `tfsdk:"objects"` //<<map[string]map[string]string
+	MaxWorkers     types.Int64  `tfsdk:"max_workers"`
+	ObjectIds      types.Map    `tfsdk:"object_ids"` //<<map[string]string
+	Changes        types.Map    `tfsdk:"changes"`    //<<map[string]string
+}
+
+// A create, update or delete of one object, identified by its key.
+type objectsTask struct {
+	Key      string
+	Action   string
+	ObjectId string
+	Values   map[string]string
+}
+
+type objectsTaskResult struct {
+	Task     objectsTask
+	ObjectId string
+	Err      error
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+	r.features = assetsClient.Features
+}
+
+// Metadata returns the resource type name.
+func (r *objectsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objects"
+}
+
+// Schema defines the schema for the resource.
+func (r *objectsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object type of the managed objects",
+			},
+			"key_attribute_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The id of the object type attribute holding the key of the objects. Its value is always taken from the key of the objects map",
+			},
+			"objects": schema.MapAttribute{
+				Required: true,
+				ElementType: types.MapType{
+					ElemType: types.StringType,
+				},
+				Description: "The managed objects, keyed by the value of their key attribute. Each object is a map of object type attribute id to value",
+			},
+			"max_workers": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultObjectsMaxWorkers),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+				Description: "The maximum number of objects created, updated or deleted concurrently. Defaults to 5",
+			},
+			"object_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of each managed object, keyed by object key",
+			},
+			"changes": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The changes (create, update or delete) planned by the last apply, keyed by object key",
+			},
+		},
+	}
+}
+
+// Converts the objects attribute, reporting false if any of its values is not known yet.
+func objectsFromMap(ctx context.Context, objectsMap types.Map) (map[string]map[string]string, bool, diag.Diagnostics) {
+	if objectsMap.IsUnknown() {
+		return nil, false, nil
+	}
+
+	var elements map[string]types.Map
+	diags := objectsMap.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	objects := make(map[string]map[string]string, len(elements))
+	for key, element := range elements {
+		if element.IsUnknown() {
+			return nil, false, diags
+		}
+
+		var values map[string]types.String
+		diags = element.ElementsAs(ctx, &values, false)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		objects[key] = make(map[string]string, len(values))
+		for attributeId, value := range values {
+			if value.IsUnknown() {
+				return nil, false, diags
+			}
+			objects[key][attributeId] = value.ValueString()
+		}
+	}
+
+	return objects, true, diags
+}
+
+func objectValuesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for attributeId, value := range a {
+		other, ok := b[attributeId]
+		if !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Indexes the live objects of the type by the value of their key attribute.
+func liveObjectsByKey(ctx context.Context, client *assets.Client, workspaceId, objectTypeId, keyAttributeId string) (map[string]*models.ObjectScheme, error) {
+	objects, err := searchObjects(ctx, client, workspaceId, "objectTypeId = "+objectTypeId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	live := make(map[string]*models.ObjectScheme, len(objects))
+	for _, object := range objects {
+		key := objectAttributeValue(object, keyAttributeId)
+		if _, ok := live[key]; key == "" || ok {
+			continue
+		}
+		live[key] = object
+	}
+
+	return live, nil
+}
+
+// Returns the first value of the attribute, or an empty string.
+func objectAttributeValue(object *models.ObjectScheme, objectTypeAttributeId string) string {
+	for _, attribute := range object.Attributes {
+		if attribute.ObjectTypeAttributeId != objectTypeAttributeId {
+			continue
+		}
+		for _, value := range attribute.ObjectAttributeValues {
+			return value.Value
+		}
+	}
+	return ""
+}
+
+// Returns the live values of the given attributes.
+func liveObjectValues(object *models.ObjectScheme, attributeIds map[string]string) map[string]string {
+	values := make(map[string]string, len(attributeIds))
+	for attributeId := range attributeIds {
+		values[attributeId] = objectAttributeValue(object, attributeId)
+	}
+	return values
+}
+
+func objectsTaskPayload(objectTypeId, keyAttributeId, key string, values map[string]string) *models.ObjectPayloadScheme {
+	attributeIds := make([]string, 0, len(values))
+	for attributeId := range values {
+		if attributeId != keyAttributeId {
+			attributeIds = append(attributeIds, attributeId)
+		}
+	}
+	sort.Strings(attributeIds)
+
+	payload := &models.ObjectPayloadScheme{
+		ObjectTypeID: objectTypeId,
+		Attributes: []*models.ObjectPayloadAttributeScheme{
+			{
+				ObjectTypeAttributeID: keyAttributeId,
+				ObjectAttributeValues: []*models.ObjectPayloadAttributeValueScheme{
+					{
+						Value: key,
+					},
+				},
+			},
+		},
+	}
+
+	for _, attributeId := range attributeIds {
+		payload.Attributes = append(payload.Attributes, &models.ObjectPayloadAttributeScheme{
+			ObjectTypeAttributeID: attributeId,
+			ObjectAttributeValues: []*models.ObjectPayloadAttributeValueScheme{
+				{
+					Value: values[attributeId],
+				},
+			},
+		})
+	}
+
+	return payload
+}
+
+// Runs the tasks with at most maxWorkers concurrent API calls.
+func runObjectsTasks(ctx context.Context, client *assets.Client, workspaceId string, features *features, objectTypeId, keyAttributeId string, maxWorkers int, tasks []objectsTask) []objectsTaskResult {
+	results := make([]objectsTaskResult, len(tasks))
+	semaphore := make(chan struct{}, maxWorkers)
+
+	var wg sync.WaitGroup
+	for index, task := range tasks {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(index int, task objectsTask) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result := objectsTaskResult{Task: task, ObjectId: task.ObjectId}
+			switch task.Action {
+			case objectsChangeCreate:
+				object, _, err := client.Object.Create(ctx, workspaceId, objectsTaskPayload(objectTypeId, keyAttributeId, task.Key, task.Values))
+				result.Err = err
+				if err == nil {
+					result.ObjectId = object.ID
+				}
+			case objectsChangeUpdate:
+				_, _, result.Err = client.Object.Update(ctx, workspaceId, task.ObjectId, objectsTaskPayload(objectTypeId, keyAttributeId, task.Key, task.Values))
+			case objectsChangeDelete:
+				result.Err = destroyObject(ctx, client, workspaceId, features, objectTypeId, task.ObjectId)
+			}
+			results[index] = result
+		}(index, task)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// Computes the changes between the previous and the planned objects.
+func objectsChanges(previous, planned map[string]map[string]string) map[string]string {
+	changes := make(map[string]string)
+	for key, values := range planned {
+		previousValues, ok := previous[key]
+		if !ok {
+			changes[key] = objectsChangeCreate
+		} else if !objectValuesEqual(previousValues, values) {
+			changes[key] = objectsChangeUpdate
+		}
+	}
+	for key := range previous {
+		if _, ok := planned[key]; !ok {
+			changes[key] = objectsChangeDelete
+		}
+	}
+	return changes
+}
+
+// ModifyPlan summarises the changes per key and keeps the object ids known when possible.
+func (r *objectsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to summarise on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan objectsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, known, diags := objectsFromMap(ctx, plan.Objects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	var state objectsResourceModel
+	exists := !req.State.Raw.IsNull()
+	if exists {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A replaced resource starts from scratch
+		exists = state.ObjectTypeId.Equal(plan.ObjectTypeId) && state.KeyAttributeId.Equal(plan.KeyAttributeId)
+	}
+
+	previous := map[string]map[string]string{}
+	previousIds := map[string]string{}
+	if exists {
+		previous, _, diags = objectsFromMap(ctx, state.Objects)
+		resp.Diagnostics.Append(diags...)
+		diags = state.ObjectIds.ElementsAs(ctx, &previousIds, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	changes := objectsChanges(previous, planned)
+
+	// Keep the summary of the last apply when nothing changes
+	if len(changes) == 0 && exists {
+		plan.Changes = state.Changes
+		plan.ObjectIds = state.ObjectIds
+	} else {
+		plan.Changes, diags = types.MapValueFrom(ctx, types.StringType, changes)
+		resp.Diagnostics.Append(diags...)
+
+		plan.ObjectIds = types.MapUnknown(types.StringType)
+		ids := make(map[string]string, len(planned))
+		for key := range planned {
+			id, ok := previousIds[key]
+			if !ok {
+				ids = nil
+				break
+			}
+			ids[key] = id
+		}
+		if ids != nil {
+			plan.ObjectIds, diags = types.MapValueFrom(ctx, types.StringType, ids)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Applies the planned objects against the live objects of the type, and
+// updates the plan with the result.
+func (r *objectsResource) apply(ctx context.Context, plan *objectsResourceModel, previous map[string]map[string]string, previousIds map[string]string) diag.Diagnostics {
+	planned, _, diags := objectsFromMap(ctx, plan.Objects)
+	if diags.HasError() {
+		return diags
+	}
+
+	live, err := liveObjectsByKey(ctx, r.client, r.workspace_id, plan.ObjectTypeId.ValueString(), plan.KeyAttributeId.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading objects",
+			"Could not read objects, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Objects that already exist are adopted and only updated when they differ.
+	var tasks []objectsTask
+	ids := make(map[string]string, len(planned))
+	for key, values := range planned {
+		object, ok := live[key]
+		if !ok {
+			tasks = append(tasks, objectsTask{Key: key, Action: objectsChangeCreate, Values: values})
+			continue
+		}
+		ids[key] = object.ID
+		if !objectValuesEqual(liveObjectValues(object, values), values) {
+			tasks = append(tasks, objectsTask{Key: key, Action: objectsChangeUpdate, ObjectId: object.ID, Values: values})
+		}
+	}
+	for key := range previous {
+		if _, ok := planned[key]; ok {
+			continue
+		}
+		if object, ok := live[key]; ok {
+			tasks = append(tasks, objectsTask{Key: key, Action: objectsChangeDelete, ObjectId: object.ID})
+		}
+	}
+
+	results := runObjectsTasks(ctx, r.client, r.workspace_id, r.features, plan.ObjectTypeId.ValueString(), plan.KeyAttributeId.ValueString(), int(plan.MaxWorkers.ValueInt64()), tasks)
+
+	// Failed changes are recorded with their previous value, so that they are
+	// planned again.
+	objects := make(map[string]map[string]string, len(planned))
+	for key, values := range planned {
+		objects[key] = values
+	}
+	for _, result := range results {
+		key := result.Task.Key
+		if result.Err == nil {
+			if result.Task.Action == objectsChangeCreate {
+				ids[key] = result.ObjectId
+			}
+			continue
+		}
+
+		diags.AddError(
+			fmt.Sprintf("Error applying object %q", key),
+			fmt.Sprintf("Could not %s object, unexpected error: %s", result.Task.Action, result.Err.Error()),
+		)
+
+		switch result.Task.Action {
+		case objectsChangeCreate:
+			delete(objects, key)
+		case objectsChangeUpdate:
+			if values, ok := previous[key]; ok {
+				objects[key] = values
+			} else {
+				objects[key] = liveObjectValues(live[key], result.Task.Values)
+			}
+		case objectsChangeDelete:
+			objects[key] = previous[key]
+			ids[key] = previousIds[key]
+		}
+	}
+
+	var d diag.Diagnostics
+	plan.Id = plan.ObjectTypeId
+	plan.Objects, d = types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, objects)
+	diags.Append(d...)
+	plan.ObjectIds, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	if plan.Changes.IsUnknown() {
+		plan.Changes, d = types.MapValueFrom(ctx, types.StringType, objectsChanges(previous, planned))
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *objectsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan objectsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan, map[string]map[string]string{}, map[string]string{})
+	resp.Diagnostics.Append(diags...)
+
+	// Set state to fully populated data, even on partial failure
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *objectsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state objectsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, _, diags := objectsFromMap(ctx, state.Objects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := liveObjectsByKey(ctx, r.client, r.workspace_id, state.ObjectTypeId.ValueString(), state.KeyAttributeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading objects",
+			"Could not read objects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Objects removed outside of Terraform are dropped, so that they are
+	// planned for creation again.
+	objects := make(map[string]map[string]string, len(previous))
+	ids := make(map[string]string, len(previous))
+	for key, values := range previous {
+		object, ok := live[key]
+		if !ok {
+			continue
+		}
+		objects[key] = liveObjectValues(object, values)
+		ids[key] = object.ID
+	}
+
+	state.Objects, diags = types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, objects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectIds, diags = types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *objectsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan objectsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state objectsResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, _, diags := objectsFromMap(ctx, state.Objects)
+	resp.Diagnostics.Append(diags...)
+	previousIds := map[string]string{}
+	diags = state.ObjectIds.ElementsAs(ctx, &previousIds, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan, previous, previousIds)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *objectsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state objectsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]string{}
+	diags = state.ObjectIds.ElementsAs(ctx, &ids, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tasks := make([]objectsTask, 0, len(ids))
+	for key, id := range ids {
+		tasks = append(tasks, objectsTask{Key: key, Action: objectsChangeDelete, ObjectId: id})
+	}
+
+	results := runObjectsTasks(ctx, r.client, r.workspace_id, r.features, state.ObjectTypeId.ValueString(), state.KeyAttributeId.ValueString(), int(state.MaxWorkers.ValueInt64()), tasks)
+
+	// Keep the objects that could not be deleted in state
+	objects, _, diags := objectsFromMap(ctx, state.Objects)
+	resp.Diagnostics.Append(diags...)
+	remainingObjects := map[string]map[string]string{}
+	remainingIds := map[string]attr.Value{}
+	for _, result := range results {
+		if result.Err == nil {
+			continue
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error Deleting object %q", result.Task.Key),
+			"Could not delete object, unexpected error: "+result.Err.Error(),
+		)
+		remainingObjects[result.Task.Key] = objects[result.Task.Key]
+		remainingIds[result.Task.Key] = types.StringValue(result.ObjectId)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Objects, diags = types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, remainingObjects)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectIds, diags = types.MapValue(types.StringType, remainingIds)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
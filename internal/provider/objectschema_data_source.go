@@ -7,6 +7,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -20,6 +21,21 @@ type objectSchemaDataSource struct {
 	workspace_id string
 }
 
+type objectSchemaDataModel struct {
+	WorkspaceId     types.String `tfsdk:"workspace_id"`
+	GlobalId        types.String `tfsdk:"global_id"`
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ObjectSchemaKey types.String `tfsdk:"object_schema_key"`
+	Description     types.String `tfsdk:"description"`
+	Status          types.String `tfsdk:"status"`
+	Created         types.String `tfsdk:"created"`
+	Updated         types.String `tfsdk:"updated"`
+	ObjectCount     types.Int64  `tfsdk:"object_count"`
+	ObjectTypeCount types.Int64  `tfsdk:"object_type_count"`
+	CanManage       types.Bool   `tfsdk:"can_manage"`
+}
+
 // NewObjectDataSource is a helper function to simplify the provider implementation.
 func NewObjectSchemaDataSource() datasource.DataSource {
 	return &objectSchemaDataSource{}
@@ -100,7 +116,7 @@ func (d *objectSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 // Read refreshes the Terraform state with the latest data.
 func (d *objectSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get current state
-	var state objectSchemaResourceModel
+	var state objectSchemaDataModel
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -116,7 +132,7 @@ func (d *objectSchemaDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	FillInformationsForObjectSchema(&state, objectschema)
+	FillInformationsForDataObjectSchema(&state, objectschema)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
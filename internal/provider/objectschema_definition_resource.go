@@ -0,0 +1,845 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &objectSchemaDefinitionResource{}
+	_ resource.ResourceWithConfigure      = &objectSchemaDefinitionResource{}
+	_ resource.ResourceWithImportState    = &objectSchemaDefinitionResource{}
+	_ resource.ResourceWithModifyPlan     = &objectSchemaDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &objectSchemaDefinitionResource{}
+)
+
+// NewObjectSchemaDefinitionResource is a helper function to simplify the provider implementation.
+func NewObjectSchemaDefinitionResource() resource.Resource {
+	return &objectSchemaDefinitionResource{}
+}
+
+// objectSchemaDefinitionResource is the resource implementation.
+type objectSchemaDefinitionResource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectSchemaDefinitionResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ObjectSchemaId types.String `tfsdk:"object_schema_id"`
+	Definition     types.String `tfsdk:"definition"`
+	Prune          types.Bool   `tfsdk:"prune"`
+	ForceDestroy   types.Bool   `tfsdk:"force_destroy"`
+	ObjectTypeIds  types.Map    `tfsdk:"object_type_ids"` //<<map[string]string
+	AttributeIds   types.Map    `tfsdk:"attribute_ids"`   //<<map[string]string
+	PendingChanges types.List   `tfsdk:"pending_changes"` //<<[]string
+	InSync         types.Bool   `tfsdk:"in_sync"`
+}
+
+// The definition document. YAML being a superset of JSON, both are read with
+// the YAML decoder.
+type schemaDefinition struct {
+	ObjectTypes []*objectTypeDefinition `yaml:"object_types"`
+}
+
+type objectTypeDefinition struct {
+	Name        string                           `yaml:"name"`
+	Description string                           `yaml:"description"`
+	IconId      string                           `yaml:"icon_id"`
+	Parent      string                           `yaml:"parent"`
+	Inherited   bool                             `yaml:"inherited"`
+	Abstract    bool                             `yaml:"abstract"`
+	Attributes  []*objectTypeAttributeDefinition `yaml:"attributes"`
+}
+
+type objectTypeAttributeDefinition struct {
+	Name                string   `yaml:"name"`
+	Type                string   `yaml:"type"`
+	Description         string   `yaml:"description"`
+	Label               bool     `yaml:"label"`
+	Unique              bool     `yaml:"unique"`
+	MinimumCardinality  *int     `yaml:"minimum_cardinality"`
+	MaximumCardinality  *int     `yaml:"maximum_cardinality"`
+	Options             []string `yaml:"options"`
+	ReferenceObjectType string   `yaml:"reference_object_type"`
+	ReferenceTypeId     string   `yaml:"reference_type_id"`
+	AqlFilter           string   `yaml:"aql_filter"`
+	Groups              []string `yaml:"groups"`
+	StatusTypeIds       []string `yaml:"status_type_ids"`
+}
+
+// The result of matching the definition against the live schema.
+type schemaDefinitionSync struct {
+	Changes       []string
+	ObjectTypeIds map[string]string
+	AttributeIds  map[string]string
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectSchemaDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Metadata returns the resource type name.
+func (r *objectSchemaDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectschema_definition"
+}
+
+// Schema defines the schema for the resource.
+func (r *objectSchemaDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_schema_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object schema holding the object types. The object types and attributes are left as they are on destroy",
+			},
+			"definition": schema.StringAttribute{
+				Required:    true,
+				Description: "The YAML or JSON document declaring the object types of the schema, their hierarchy and their attributes. Object types are matched by name, attributes by name within their object type",
+			},
+			"prune": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete the object types and attributes of the schema which are not declared in the definition. System attributes are kept. Defaults to false",
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete the objects of the undeclared object types pruned. Otherwise pruning an object type which still contains objects fails. Defaults to false",
+			},
+			"object_type_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of each object type of the definition, keyed by name",
+			},
+			"attribute_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of each attribute of the definition, keyed by object type name and attribute name joined with a dot",
+			},
+			"pending_changes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The changes needed for the schema to match the definition, as planned or found at the last refresh, in the order they are applied. An apply fails when the schema no longer needs the planned changes",
+			},
+			"in_sync": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the schema matched the definition at the last refresh. An apply is planned when it doesn't",
+			},
+		},
+	}
+}
+
+// Parses the definition and checks it. The object types are returned parents
+// first, which is the order they are created in.
+func parseSchemaDefinition(document string) ([]*objectTypeDefinition, error) {
+	var definition schemaDefinition
+	if err := yaml.UnmarshalStrict([]byte(document), &definition); err != nil {
+		return nil, err
+	}
+
+	objectTypes := make(map[string]*objectTypeDefinition, len(definition.ObjectTypes))
+	for _, objectType := range definition.ObjectTypes {
+		if objectType.Name == "" {
+			return nil, fmt.Errorf("an object type has no name")
+		}
+		if _, ok := objectTypes[objectType.Name]; ok {
+			return nil, fmt.Errorf("object type %q is declared twice", objectType.Name)
+		}
+		if objectType.IconId == "" {
+			return nil, fmt.Errorf("object type %q: icon_id is required", objectType.Name)
+		}
+		objectTypes[objectType.Name] = objectType
+	}
+
+	for _, objectType := range definition.ObjectTypes {
+		if objectType.Parent != "" && objectTypes[objectType.Parent] == nil {
+			return nil, fmt.Errorf("object type %q: parent %q is not declared", objectType.Name, objectType.Parent)
+		}
+
+		attributes := make(map[string]bool, len(objectType.Attributes))
+		for _, attribute := range objectType.Attributes {
+			if err := checkAttributeDefinition(attribute, objectTypes); err != nil {
+				return nil, fmt.Errorf("object type %q: %s", objectType.Name, err.Error())
+			}
+			if attributes[attribute.Name] {
+				return nil, fmt.Errorf("object type %q: attribute %q is declared twice", objectType.Name, attribute.Name)
+			}
+			attributes[attribute.Name] = true
+		}
+	}
+
+	// Order the object types parents first, detecting cycles
+	ordered := make([]*objectTypeDefinition, 0, len(definition.ObjectTypes))
+	state := make(map[string]int, len(definition.ObjectTypes)) // 1 visiting, 2 done
+	var visit func(objectType *objectTypeDefinition) error
+	visit = func(objectType *objectTypeDefinition) error {
+		switch state[objectType.Name] {
+		case 1:
+			return fmt.Errorf("object type %q is its own ancestor", objectType.Name)
+		case 2:
+			return nil
+		}
+		state[objectType.Name] = 1
+		if objectType.Parent != "" {
+			if err := visit(objectTypes[objectType.Parent]); err != nil {
+				return err
+			}
+		}
+		state[objectType.Name] = 2
+		ordered = append(ordered, objectType)
+		return nil
+	}
+	for _, objectType := range definition.ObjectTypes {
+		if err := visit(objectType); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+func checkAttributeDefinition(attribute *objectTypeAttributeDefinition, objectTypes map[string]*objectTypeDefinition) error {
+	if attribute.Name == "" {
+		return fmt.Errorf("an attribute has no name")
+	}
+
+	if _, ok := objectTypeAttributeBlockTypes[attribute.Type]; ok && attribute.Type != "default" {
+		if attribute.Type == "reference" {
+			if objectTypes[attribute.ReferenceObjectType] == nil {
+				return fmt.Errorf("attribute %q: reference_object_type %q is not declared", attribute.Name, attribute.ReferenceObjectType)
+			}
+			if attribute.ReferenceTypeId == "" {
+				return fmt.Errorf("attribute %q: reference_type_id is required", attribute.Name)
+			}
+		}
+	} else if defaultTypeKindId(attribute.Type) < 0 {
+		return fmt.Errorf("attribute %q: unknown type %q", attribute.Name, attribute.Type)
+	}
+
+	if len(attribute.Options) > 0 && attribute.Type != "select" {
+		return fmt.Errorf("attribute %q: options can only be set on a select attribute", attribute.Name)
+	}
+
+	return nil
+}
+
+// Returns the default type id of a kind of default attribute, -1 when the
+// kind is unknown.
+func defaultTypeKindId(kind string) int {
+	for id, name := range defaultTypeKinds {
+		if name == kind {
+			return id
+		}
+	}
+	return -1
+}
+
+// Builds the object type attribute payload of an attribute of the definition.
+func objectTypeAttributeDefinitionPayload(attribute *objectTypeAttributeDefinition, objectTypeIds map[string]string) *models.ObjectTypeAttributePayloadScheme {
+	payload := &models.ObjectTypeAttributePayloadScheme{
+		Name:               attribute.Name,
+		Description:        attribute.Description,
+		Label:              attribute.Label,
+		UniqueAttribute:    attribute.Unique,
+		MinimumCardinality: attribute.MinimumCardinality,
+		MaximumCardinality: attribute.MaximumCardinality,
+	}
+
+	Type, ok := objectTypeAttributeBlockTypes[attribute.Type]
+	if !ok {
+		DefaultTypeId := defaultTypeKindId(attribute.Type)
+		payload.DefaultTypeId = &DefaultTypeId
+	}
+	attributeType := int(Type)
+	payload.Type = &attributeType
+
+	switch attribute.Type {
+	case "select":
+		payload.Options = strings.Join(attribute.Options, ",")
+	case "reference":
+		payload.TypeValue = objectTypeIds[attribute.ReferenceObjectType]
+		payload.AdditionalValue = attribute.ReferenceTypeId
+		payload.Iql = attribute.AqlFilter
+	case "user":
+		payload.TypeValueMulti = attribute.Groups
+	case "status":
+		payload.TypeValueMulti = attribute.StatusTypeIds
+	}
+
+	return payload
+}
+
+// Joins a list of values in a stable order, for values whose order is not
+// significant.
+func joinSorted(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// Whether a live object type differs from its definition.
+func objectTypeDefinitionDiffers(objectType *models.ObjectTypeScheme, payload *models.ObjectTypePayloadScheme) bool {
+	iconId := ""
+	if objectType.Icon != nil {
+		iconId = objectType.Icon.ID
+	}
+
+	return objectType.Description != payload.Description ||
+		iconId != payload.IconId ||
+		objectType.ParentObjectTypeId != payload.ParentObjectTypeId ||
+		objectType.Inherited != payload.Inherited ||
+		objectType.AbstractObjectType != payload.AbstractObjectType
+}
+
+// Whether a live attribute differs from its definition. Only the values the
+// definition sets are compared.
+func objectTypeAttributeDefinitionDiffers(attribute *models.ObjectTypeAttributeScheme, payload *models.ObjectTypeAttributePayloadScheme) bool {
+	if attribute.Type != *payload.Type || attribute.Description != payload.Description {
+		return true
+	}
+	if payload.Label && !attribute.Label {
+		return true
+	}
+	if attribute.UniqueAttribute != payload.UniqueAttribute {
+		return true
+	}
+	if payload.MinimumCardinality != nil && attribute.MinimumCardinality != *payload.MinimumCardinality {
+		return true
+	}
+	if payload.MaximumCardinality != nil && attribute.MaximumCardinality != *payload.MaximumCardinality {
+		return true
+	}
+
+	switch *payload.Type {
+	case 0:
+		if attribute.DefaultType == nil || attribute.DefaultType.ID != *payload.DefaultTypeId {
+			return true
+		}
+		return attribute.Options != payload.Options
+	case 1:
+		// The reference type is only compared when the API returns it
+		if attribute.AdditionalValue != "" && attribute.AdditionalValue != payload.AdditionalValue {
+			return true
+		}
+		return attribute.ReferenceObjectTypeId != payload.TypeValue || attribute.Iql != payload.Iql
+	case 2, 7:
+		return joinSorted(attribute.TypeValueMulti) != joinSorted(payload.TypeValueMulti)
+	}
+
+	return false
+}
+
+// Matches the definition against the live schema and lists the changes, in
+// dependency order: object types parents first, then their attributes, then
+// what is pruned. The changes are made when apply is true, otherwise the ids
+// of the object types and attributes still to create are missing.
+func (r *objectSchemaDefinitionResource) sync(ctx context.Context, model objectSchemaDefinitionResourceModel, apply bool) (*schemaDefinitionSync, error) {
+	objectSchemaId := model.ObjectSchemaId.ValueString()
+
+	definitions, err := parseSchemaDefinition(model.Definition.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid definition: %s", err.Error())
+	}
+
+	liveObjectTypes, _, err := r.client.ObjectSchema.ObjectTypes(ctx, r.workspace_id, objectSchemaId, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the object types of objectschema %s: %s", objectSchemaId, err.Error())
+	}
+	liveByName := make(map[string]*models.ObjectTypeScheme, len(liveObjectTypes))
+	for _, objectType := range liveObjectTypes {
+		liveByName[objectType.Name] = objectType
+	}
+
+	result := &schemaDefinitionSync{
+		Changes:       []string{},
+		ObjectTypeIds: map[string]string{},
+		AttributeIds:  map[string]string{},
+	}
+
+	// Object types, parents first
+	for _, definition := range definitions {
+		payload := &models.ObjectTypePayloadScheme{
+			Name:               definition.Name,
+			Description:        definition.Description,
+			IconId:             definition.IconId,
+			ObjectSchemaId:     objectSchemaId,
+			ParentObjectTypeId: result.ObjectTypeIds[definition.Parent],
+			Inherited:          definition.Inherited,
+			AbstractObjectType: definition.Abstract,
+		}
+
+		live, ok := liveByName[definition.Name]
+		if !ok {
+			result.Changes = append(result.Changes, "create object type "+definition.Name)
+			if apply {
+				objectType, _, err := r.client.ObjectType.Create(ctx, r.workspace_id, payload)
+				if err != nil {
+					return result, fmt.Errorf("could not create object type %s: %s", definition.Name, err.Error())
+				}
+				result.ObjectTypeIds[definition.Name] = objectType.Id
+			}
+			continue
+		}
+
+		result.ObjectTypeIds[definition.Name] = live.Id
+		if !objectTypeDefinitionDiffers(live, payload) {
+			continue
+		}
+
+		result.Changes = append(result.Changes, "update object type "+definition.Name)
+		if apply {
+			_, _, err := r.client.ObjectType.Update(ctx, r.workspace_id, live.Id, payload)
+			if err != nil {
+				return result, fmt.Errorf("could not update object type %s: %s", definition.Name, err.Error())
+			}
+			if live.ParentObjectTypeId != payload.ParentObjectTypeId {
+				_, err = moveObjectType(ctx, r.client, r.workspace_id, live.Id, payload.ParentObjectTypeId, live.Position)
+				if err != nil {
+					return result, fmt.Errorf("could not move object type %s: %s", definition.Name, err.Error())
+				}
+			}
+		}
+	}
+
+	// Attributes, once every referenced object type exists
+	for _, definition := range definitions {
+		objectTypeId := result.ObjectTypeIds[definition.Name]
+
+		liveAttributes := map[string]*models.ObjectTypeAttributeScheme{}
+		if objectTypeId != "" {
+			attributes, _, err := r.client.ObjectType.Attributes(ctx, r.workspace_id, objectTypeId, &models.ObjectTypeAttributesParamsScheme{
+				ExcludeParentAttributes: true,
+			})
+			if err != nil {
+				return result, fmt.Errorf("could not read the attributes of object type %s: %s", definition.Name, err.Error())
+			}
+			for _, attribute := range attributes {
+				liveAttributes[attribute.Name] = attribute
+			}
+		}
+
+		declared := make(map[string]bool, len(definition.Attributes))
+		for _, attributeDefinition := range definition.Attributes {
+			declared[attributeDefinition.Name] = true
+			key := definition.Name + "." + attributeDefinition.Name
+			payload := objectTypeAttributeDefinitionPayload(attributeDefinition, result.ObjectTypeIds)
+
+			live, ok := liveAttributes[attributeDefinition.Name]
+			switch {
+			case !ok:
+				result.Changes = append(result.Changes, "create attribute "+key)
+				if apply {
+					attribute, _, err := r.client.ObjectTypeAttribute.Create(ctx, r.workspace_id, objectTypeId, payload)
+					if err != nil {
+						return result, fmt.Errorf("could not create attribute %s: %s", key, err.Error())
+					}
+					result.AttributeIds[key] = attribute.ID
+				}
+				continue
+			case objectTypeAttributeDefinitionDiffers(live, payload):
+				result.Changes = append(result.Changes, "update attribute "+key)
+				if apply {
+					_, _, err := r.client.ObjectTypeAttribute.Update(ctx, r.workspace_id, objectTypeId, live.ID, payload)
+					if err != nil {
+						return result, fmt.Errorf("could not update attribute %s: %s", key, err.Error())
+					}
+				}
+			}
+			result.AttributeIds[key] = live.ID
+		}
+
+		if !model.Prune.ValueBool() {
+			continue
+		}
+
+		names := make([]string, 0, len(liveAttributes))
+		for name, attribute := range liveAttributes {
+			if !declared[name] && !attribute.System {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			result.Changes = append(result.Changes, "delete attribute "+definition.Name+"."+name)
+			if apply {
+				_, err := r.client.ObjectTypeAttribute.Delete(ctx, r.workspace_id, liveAttributes[name].ID)
+				if err != nil {
+					return result, fmt.Errorf("could not delete attribute %s.%s: %s", definition.Name, name, err.Error())
+				}
+			}
+		}
+	}
+
+	if !model.Prune.ValueBool() {
+		return result, nil
+	}
+
+	// Undeclared object types, children first
+	liveById := make(map[string]*models.ObjectTypeScheme, len(liveObjectTypes))
+	for _, objectType := range liveObjectTypes {
+		liveById[objectType.Id] = objectType
+	}
+
+	var undeclared []*models.ObjectTypeScheme
+	for _, objectType := range liveObjectTypes {
+		if _, ok := result.ObjectTypeIds[objectType.Name]; !ok {
+			undeclared = append(undeclared, objectType)
+		}
+	}
+	sort.SliceStable(undeclared, func(i, j int) bool {
+		return objectTypeDepth(undeclared[i], liveById) > objectTypeDepth(undeclared[j], liveById)
+	})
+
+	for _, objectType := range undeclared {
+		// Object types holding objects are only pruned with force_destroy,
+		// the refused deletion is still reported so that the plan shows it
+		if objectType.ObjectCount > 0 && !model.ForceDestroy.ValueBool() {
+			change := fmt.Sprintf("delete object type %s: refused, it still contains %d objects and force_destroy is not set", objectType.Name, objectType.ObjectCount)
+			result.Changes = append(result.Changes, change)
+			if apply {
+				return result, fmt.Errorf("could not delete object type %s, it still contains %d objects. Set force_destroy to true to destroy them along with the object type", objectType.Name, objectType.ObjectCount)
+			}
+			continue
+		}
+
+		result.Changes = append(result.Changes, "delete object type "+objectType.Name)
+		if apply {
+			if objectType.ObjectCount > 0 {
+				err := destroyContainedObjects(ctx, r.client, r.workspace_id, "objectTypeId = "+objectType.Id)
+				if err != nil {
+					return result, fmt.Errorf("could not destroy the objects of object type %s: %s", objectType.Name, err.Error())
+				}
+			}
+
+			_, _, err := r.client.ObjectType.Delete(ctx, r.workspace_id, objectType.Id)
+			if err != nil {
+				return result, fmt.Errorf("could not delete object type %s: %s", objectType.Name, err.Error())
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Sets the ids of the model from the result of a sync.
+func (r *objectSchemaDefinitionResource) fill(ctx context.Context, model *objectSchemaDefinitionResourceModel, result *schemaDefinitionSync) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Id = model.ObjectSchemaId
+
+	model.ObjectTypeIds, diags = types.MapValueFrom(ctx, types.StringType, result.ObjectTypeIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.AttributeIds, diags = types.MapValueFrom(ctx, types.StringType, result.AttributeIds)
+	return diags
+}
+
+// Makes the changes shown in the plan. The schema is compared with the
+// definition again first, and nothing is changed when that no longer gives
+// the planned changes. When the changes were unknown at plan time, because the
+// provider or the definition were not known yet, nothing is deleted.
+func (r *objectSchemaDefinitionResource) apply(ctx context.Context, plan *objectSchemaDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expected, err := r.sync(ctx, *plan, false)
+	if err != nil {
+		diags.AddError(
+			"Error applying objectschema definition",
+			"Could not compare objectschema with its definition, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if plan.PendingChanges.IsUnknown() {
+		for _, change := range expected.Changes {
+			if strings.HasPrefix(change, "delete ") {
+				diags.AddError(
+					"Error applying objectschema definition",
+					"The changes were unknown at plan time and include: "+change+". Deletions are only made once shown in a plan, plan again to review them.",
+				)
+				return diags
+			}
+		}
+	} else {
+		var planned []string
+		diags = plan.PendingChanges.ElementsAs(ctx, &planned, false)
+		if diags.HasError() {
+			return diags
+		}
+
+		if strings.Join(planned, "\n") != strings.Join(expected.Changes, "\n") {
+			diags.AddError(
+				"Error applying objectschema definition",
+				"The objectschema changed since the plan, the changes now needed are:\n"+strings.Join(expected.Changes, "\n")+"\nPlan again to review them.",
+			)
+			return diags
+		}
+	}
+
+	result, err := r.sync(ctx, *plan, true)
+	if err != nil {
+		diags.AddError(
+			"Error applying objectschema definition",
+			"Could not apply objectschema definition, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	diags = r.fill(ctx, plan, result)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.InSync = types.BoolValue(true)
+	if plan.PendingChanges.IsUnknown() {
+		plan.PendingChanges, diags = types.ListValueFrom(ctx, types.StringType, expected.Changes)
+	}
+	return diags
+}
+
+// ValidateConfig reports an invalid definition at plan time.
+func (r *objectSchemaDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var definition types.String
+	diags := req.Config.GetAttribute(ctx, path.Root("definition"), &definition)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || definition.IsNull() || definition.IsUnknown() {
+		return
+	}
+
+	if _, err := parseSchemaDefinition(definition.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition"),
+			"Invalid objectschema definition",
+			err.Error(),
+		)
+	}
+}
+
+// ModifyPlan plans a sync whenever the definition changed or the schema no
+// longer matched it at the last refresh. The planned changes are computed
+// once the provider and the definition are known.
+func (r *objectSchemaDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan objectSchemaDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unchanged := false
+	if !req.State.Raw.IsNull() {
+		var state objectSchemaDefinitionResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		unchanged = state.Definition.Equal(plan.Definition) &&
+			state.Prune.Equal(plan.Prune) &&
+			state.ForceDestroy.Equal(plan.ForceDestroy) &&
+			state.InSync.ValueBool()
+
+		if unchanged {
+			plan.ObjectTypeIds = state.ObjectTypeIds
+			plan.AttributeIds = state.AttributeIds
+			plan.PendingChanges = state.PendingChanges
+			plan.InSync = state.InSync
+		}
+	}
+
+	if !unchanged {
+		plan.ObjectTypeIds = types.MapUnknown(types.StringType)
+		plan.AttributeIds = types.MapUnknown(types.StringType)
+		plan.PendingChanges = types.ListUnknown(types.StringType)
+		plan.InSync = types.BoolValue(true)
+
+		known := r.client != nil &&
+			!plan.ObjectSchemaId.IsUnknown() &&
+			!plan.Definition.IsUnknown() &&
+			!plan.Prune.IsUnknown() &&
+			!plan.ForceDestroy.IsUnknown()
+
+		if known {
+			result, err := r.sync(ctx, plan, false)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error planning objectschema definition",
+					"Could not compare objectschema with its definition, unexpected error: "+err.Error(),
+				)
+				return
+			}
+
+			plan.PendingChanges, diags = types.ListValueFrom(ctx, types.StringType, result.Changes)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			// Every id is already known when there is nothing to change
+			if len(result.Changes) == 0 {
+				diags = r.fill(ctx, &plan, result)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *objectSchemaDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan objectSchemaDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *objectSchemaDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state objectSchemaDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An imported definition is only known once configured
+	if state.Definition.IsNull() {
+		state.Id = state.ObjectSchemaId
+		state.ObjectTypeIds = types.MapValueMust(types.StringType, nil)
+		state.AttributeIds = types.MapValueMust(types.StringType, nil)
+		state.PendingChanges = types.ListValueMust(types.StringType, nil)
+		state.InSync = types.BoolValue(false)
+	} else {
+		result, err := r.sync(ctx, state, false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading objectschema definition",
+				"Could not compare objectschema with its definition, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		diags = r.fill(ctx, &state, result)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.InSync = types.BoolValue(len(result.Changes) == 0)
+		state.PendingChanges, diags = types.ListValueFrom(ctx, types.StringType, result.Changes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *objectSchemaDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan objectSchemaDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.apply(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state, the object types and
+// attributes of the schema are left as they are.
+func (r *objectSchemaDefinitionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *objectSchemaDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and object_schema_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_schema_id"), req.ID)...)
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &objectSchemaPropertiesResource{}
+	_ resource.ResourceWithConfigure   = &objectSchemaPropertiesResource{}
+	_ resource.ResourceWithImportState = &objectSchemaPropertiesResource{}
+)
+
+// NewObjectSchemaPropertiesResource is a helper function to simplify the provider implementation.
+func NewObjectSchemaPropertiesResource() resource.Resource {
+	return &objectSchemaPropertiesResource{}
+}
+
+// objectSchemaPropertiesResource is the resource implementation.
+type objectSchemaPropertiesResource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectSchemaPropertiesResourceModel struct {
+	Id                          types.String `tfsdk:"id"`
+	ObjectSchemaId              types.String `tfsdk:"object_schema_id"`
+	AllowOtherObjectSchema      types.Bool   `tfsdk:"allow_other_object_schema"`
+	CreateObjectsCustomField    types.Bool   `tfsdk:"create_objects_custom_field"`
+	ServiceDeskCustomersEnabled types.Bool   `tfsdk:"service_desk_customers_enabled"`
+	QuickCreateObjects          types.Bool   `tfsdk:"quick_create_objects"`
+	ValidateQuickCreate         types.Bool   `tfsdk:"validate_quick_create"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectSchemaPropertiesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Metadata returns the resource type name.
+func (r *objectSchemaPropertiesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectschema_properties"
+}
+
+// Schema defines the schema for the resource.
+func (r *objectSchemaPropertiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	property := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Computed: true,
+			Optional: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+			Description: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_schema_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object schema the properties belong to. The properties are left as they are on destroy",
+			},
+			"allow_other_object_schema":      property("Allow the attributes of the schema to reference object types of other schemas"),
+			"create_objects_custom_field":    property("Allow the creation of objects from the Assets custom field in Jira"),
+			"service_desk_customers_enabled": property("Allow the service desk customers to see the objects of the schema"),
+			"quick_create_objects":           property("Allow the quick creation of objects"),
+			"validate_quick_create":          property("Validate the mandatory attributes of the objects quick created"),
+		},
+	}
+}
+
+// Builds the payload from the plan, keeping the current value of the
+// properties which are not configured.
+func createObjectSchemaPropertiesPayload(plan objectSchemaPropertiesResourceModel, current *objectSchemaPropertiesScheme) *objectSchemaPropertiesScheme {
+	payload := *current
+	value := func(property types.Bool, current bool) bool {
+		if property.IsNull() || property.IsUnknown() {
+			return current
+		}
+		return property.ValueBool()
+	}
+
+	payload.AllowOtherObjectSchema = value(plan.AllowOtherObjectSchema, current.AllowOtherObjectSchema)
+	payload.CreateObjectsCustomField = value(plan.CreateObjectsCustomField, current.CreateObjectsCustomField)
+	payload.ServiceDescCustomersEnabled = value(plan.ServiceDeskCustomersEnabled, current.ServiceDescCustomersEnabled)
+	payload.QuickCreateObjects = value(plan.QuickCreateObjects, current.QuickCreateObjects)
+	payload.ValidateQuickCreate = value(plan.ValidateQuickCreate, current.ValidateQuickCreate)
+
+	return &payload
+}
+
+// Updates the properties of the schema and reads them back into the plan.
+func (r *objectSchemaPropertiesResource) apply(ctx context.Context, plan *objectSchemaPropertiesResourceModel) error {
+	objectSchemaId := plan.ObjectSchemaId.ValueString()
+
+	current, _, err := getObjectSchemaProperties(ctx, r.client, r.workspace_id, objectSchemaId)
+	if err != nil {
+		return err
+	}
+
+	err = updateObjectSchemaProperties(ctx, r.client, r.workspace_id, objectSchemaId, createObjectSchemaPropertiesPayload(*plan, current))
+	if err != nil {
+		return err
+	}
+
+	properties, _, err := getObjectSchemaProperties(ctx, r.client, r.workspace_id, objectSchemaId)
+	if err != nil {
+		return err
+	}
+
+	FillInformationsForObjectSchemaProperties(plan, objectSchemaId, properties)
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *objectSchemaPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan objectSchemaPropertiesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating objectschema properties",
+			"Could not update objectschema properties, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *objectSchemaPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state objectSchemaPropertiesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties, response, err := getObjectSchemaProperties(ctx, r.client, r.workspace_id, state.ObjectSchemaId.ValueString())
+	if err != nil {
+		if response == nil || response.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Error Reading objectschema properties",
+				"Could not read objectschema properties, unexpected error: "+err.Error(),
+			)
+		} else {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	FillInformationsForObjectSchemaProperties(&state, state.ObjectSchemaId.ValueString(), properties)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *objectSchemaPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan objectSchemaPropertiesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating objectschema properties",
+			"Could not update objectschema properties, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state, the properties of the
+// schema are left as they are.
+func (r *objectSchemaPropertiesResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *objectSchemaPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and object_schema_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_schema_id"), req.ID)...)
+}
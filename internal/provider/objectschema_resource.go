@@ -51,6 +51,7 @@ type objectSchemaResourceModel struct {
 	ObjectCount     types.Int64  `tfsdk:"object_count"`
 	ObjectTypeCount types.Int64  `tfsdk:"object_type_count"`
 	CanManage       types.Bool   `tfsdk:"can_manage"`
+	ForceDestroy    types.Bool   `tfsdk:"force_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -159,6 +160,10 @@ func (r *objectSchemaResource) Schema(_ context.Context, _ resource.SchemaReques
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete the objects of the object schema before deleting it, even when features.destroy_object is false. Otherwise deleting an object schema which still contains objects fails. Defaults to false",
+			},
 		},
 	}
 }
@@ -277,8 +282,41 @@ func (r *objectSchemaResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	// Refuse to delete an object schema which still contains objects
+	objectSchema, response, err := r.client.ObjectSchema.Get(ctx, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		// Already deleted
+		if response != nil && response.Code == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting objectschema",
+			"Could not read objectschema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if objectSchema.ObjectCount > 0 {
+		if !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Error Deleting objectschema",
+				fmt.Sprintf("objectschema %s still contains %d objects. Set force_destroy to true to destroy them along with the object schema.", objectSchema.Name, objectSchema.ObjectCount),
+			)
+			return
+		}
+
+		err = destroyContainedObjects(ctx, r.client, r.workspace_id, "objectSchemaId = "+state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting objectschema",
+				"Could not destroy the objects of objectschema, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Delete existing object
-	_, _, err := r.client.ObjectSchema.Delete(ctx, r.workspace_id, state.Id.ValueString())
+	_, _, err = r.client.ObjectSchema.Delete(ctx, r.workspace_id, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting objectschema",
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectSchemasDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectSchemasDataSource{}
+)
+
+type objectSchemasDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectSchemasDataSourceModel struct {
+	NameRegex       types.String `tfsdk:"name_regex"`
+	ObjectSchemaKey types.String `tfsdk:"object_schema_key"`
+	ObjectSchemas   types.List   `tfsdk:"object_schemas"` //<<[]objectSchemaDataModel
+}
+
+// NewObjectSchemasDataSource is a helper function to simplify the provider implementation.
+func NewObjectSchemasDataSource() datasource.DataSource {
+	return &objectSchemasDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectschemas"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectSchemasDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "A regular expression the name of the object schemas must match",
+			},
+			"object_schema_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "The key of the object schema to return",
+			},
+			"object_schemas": schema.ListAttribute{
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: objectSchemaAttrTypes(),
+				},
+				Description: "The matching object schemas, with the same attributes as the assets_objectschema data source",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectSchemasDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid name_regex",
+				"Could not compile name_regex, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	objectschemas, err := listObjectSchemas(ctx, d.client, d.workspace_id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading objectschemas",
+			"Could not read objectschemas, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	values := []attr.Value{}
+	for _, objectschema := range objectschemas {
+		if nameRegex != nil && !nameRegex.MatchString(objectschema.Name) {
+			continue
+		}
+		if !state.ObjectSchemaKey.IsNull() && objectschema.ObjectSchemaKey != state.ObjectSchemaKey.ValueString() {
+			continue
+		}
+
+		var objectSchema objectSchemaDataModel
+		FillInformationsForDataObjectSchema(&objectSchema, objectschema)
+
+		value, diags := types.ObjectValueFrom(ctx, objectSchemaAttrTypes(), objectSchema)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		values = append(values, value)
+	}
+
+	state.ObjectSchemas, diags = types.ListValue(types.ObjectType{AttrTypes: objectSchemaAttrTypes()}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func objectSchemaAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"workspace_id":      types.StringType,
+		"global_id":         types.StringType,
+		"id":                types.StringType,
+		"name":              types.StringType,
+		"object_schema_key": types.StringType,
+		"description":       types.StringType,
+		"status":            types.StringType,
+		"created":           types.StringType,
+		"updated":           types.StringType,
+		"object_count":      types.Int64Type,
+		"object_type_count": types.Int64Type,
+		"can_manage":        types.BoolType,
+	}
+}
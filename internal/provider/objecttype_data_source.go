@@ -7,12 +7,14 @@ import (
 	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &objectTypeDataSource{}
-	_ datasource.DataSourceWithConfigure = &objectTypeDataSource{}
+	_ datasource.DataSource                   = &objectTypeDataSource{}
+	_ datasource.DataSourceWithConfigure      = &objectTypeDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &objectTypeDataSource{}
 )
 
 type objectTypeDataSource struct {
@@ -20,6 +22,24 @@ type objectTypeDataSource struct {
 	workspace_id string
 }
 
+type objectTypeDataModel struct {
+	WorkspaceId               types.String `tfsdk:"workspace_id"`
+	GlobalId                  types.String `tfsdk:"global_id"`
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Description               types.String `tfsdk:"description"`
+	IconId                    types.String `tfsdk:"icon_id"`
+	Position                  types.Int64  `tfsdk:"position"`
+	Created                   types.String `tfsdk:"created"`
+	Updated                   types.String `tfsdk:"updated"`
+	ObjectCount               types.Int64  `tfsdk:"object_count"`
+	ParentObjectTypeId        types.String `tfsdk:"parent_object_type_id"`
+	ObjectSchemaId            types.String `tfsdk:"object_schema_id"`
+	Inherited                 types.Bool   `tfsdk:"inherited"`
+	AbstractObjectType        types.Bool   `tfsdk:"abstract_object_type"`
+	ParentObjectTypeInherited types.Bool   `tfsdk:"parent_object_type_inherited"`
+}
+
 // NewObjectDataSource is a helper function to simplify the provider implementation.
 func NewObjectTypeDataSource() datasource.DataSource {
 	return &objectTypeDataSource{}
@@ -62,10 +82,14 @@ func (d *objectTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed: true,
 			},
 			"id": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "The object type id. Either id or object_schema_id and name must be set",
 			},
 			"name": schema.StringAttribute{
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the object type. Set it with object_schema_id to look the object type up by name",
 			},
 			"description": schema.StringAttribute{
 				Computed: true,
@@ -90,6 +114,7 @@ func (d *objectTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Description: "The id of the parent object type",
 			},
 			"object_schema_id": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
 			},
 			"inherited": schema.BoolAttribute{
@@ -107,17 +132,78 @@ func (d *objectTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	}
 }
 
+// ValidateConfig checks that the object type is looked up either by id or by name.
+func (d *objectTypeDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config objectTypeDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Id.IsUnknown() || config.Name.IsUnknown() || config.ObjectSchemaId.IsUnknown() {
+		return
+	}
+
+	byName := !config.Name.IsNull() && !config.ObjectSchemaId.IsNull()
+	if config.Id.IsNull() == byName {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Invalid objecttype lookup",
+		"Either id, or object_schema_id and name, must be set.",
+	)
+}
+
+// Finds the id of the object type of the schema with the given name.
+func (d *objectTypeDataSource) lookupObjectTypeId(ctx context.Context, objectSchemaId, name string) (string, error) {
+	objecttypes, _, err := d.client.ObjectSchema.ObjectTypes(ctx, d.workspace_id, objectSchemaId, false)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, objecttype := range objecttypes {
+		if objecttype.Name == name {
+			ids = append(ids, objecttype.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no objecttype named %q in objectschema %s", name, objectSchemaId)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d objecttypes named %q in objectschema %s", len(ids), name, objectSchemaId)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *objectTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get current state
-	var state objectTypeResourceModel
+	var state objectTypeDataModel
 	diags := req.Config.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	objecttype, _, err := d.client.ObjectType.Get(ctx, d.workspace_id, state.Id.ValueString())
+	id := state.Id.ValueString()
+	if state.Id.IsNull() {
+		var err error
+		id, err = d.lookupObjectTypeId(ctx, state.ObjectSchemaId.ValueString(), state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading objecttype",
+				"Could not find objecttype, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	objecttype, _, err := d.client.ObjectType.Get(ctx, d.workspace_id, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading objecttype",
@@ -126,7 +212,7 @@ func (d *objectTypeDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	FillInformationsForObjectType(&state, objecttype)
+	FillInformationsForDataObjectType(&state, objecttype)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +23,7 @@ var (
 	_ resource.Resource                = &objectTypeResource{}
 	_ resource.ResourceWithConfigure   = &objectTypeResource{}
 	_ resource.ResourceWithImportState = &objectTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &objectTypeResource{}
 )
 
 // NewObjectResource is a helper function to simplify the provider implementation.
@@ -50,6 +53,7 @@ type objectTypeResourceModel struct {
 	Inherited                 types.Bool   `tfsdk:"inherited"`
 	AbstractObjectType        types.Bool   `tfsdk:"abstract_object_type"`
 	ParentObjectTypeInherited types.Bool   `tfsdk:"parent_object_type_inherited"`
+	ForceDestroy              types.Bool   `tfsdk:"force_destroy"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -115,9 +119,14 @@ func (r *objectTypeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"position": schema.Int64Attribute{
 				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "The position of the object type among its siblings",
 			},
 			"created": schema.StringAttribute{
 				Computed: true,
@@ -140,7 +149,7 @@ func (r *objectTypeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
-				Description: "The id of the parent object type",
+				Description: "The id of the parent object type, the object type is at the root of the schema when not set. Changing it moves the object type in the tree of the schema",
 			},
 			"object_schema_id": schema.StringAttribute{
 				Required: true,
@@ -170,6 +179,10 @@ func (r *objectTypeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 				Description: "Describes if this object types parent is inherited i.e. this object type has attributes that are inherited from one or more parents",
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete the objects of the object type before deleting it, even when features.destroy_object is false. Otherwise deleting an object type which still contains objects fails. Defaults to false",
+			},
 		},
 	}
 }
@@ -184,6 +197,119 @@ func createObjectTypePayload(objectType objectTypeResourceModel, payload *models
 	payload.AbstractObjectType = objectType.AbstractObjectType.ValueBool()
 }
 
+// ModifyPlan validates a change of parent against the inheritance rules, so
+// that an impossible move is reported at plan time.
+func (r *objectTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state, config objectTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing the parent from the configuration moves the object type back
+	// to the root of the schema
+	if config.ParentObjectTypeId.IsNull() && !plan.ParentObjectTypeId.Equal(types.StringValue("")) {
+		plan.ParentObjectTypeId = types.StringValue("")
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parent_object_type_id"), plan.ParentObjectTypeId)...)
+	}
+
+	if plan.ParentObjectTypeId.IsUnknown() || plan.ParentObjectTypeId.Equal(state.ParentObjectTypeId) {
+		return
+	}
+
+	// Without a configured provider the move is only checked by the API on apply
+	if r.client != nil {
+		err := r.validateParent(ctx, state, plan.ParentObjectTypeId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_object_type_id"),
+				"Invalid parent object type",
+				"Could not move objecttype "+state.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// The position among the new siblings is only known after the move
+	if config.Position.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("position"), types.Int64Unknown())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parent_object_type_inherited"), types.BoolUnknown())...)
+}
+
+// Checks that an object type can be moved under a new parent: the parent
+// belongs to the same schema and is not a descendant of the object type, and
+// no inherited attributes are lost or gained by the move.
+func (r *objectTypeResource) validateParent(ctx context.Context, objectType objectTypeResourceModel, parentId string) error {
+	if objectType.ParentObjectTypeInherited.ValueBool() {
+		return fmt.Errorf("it inherits the attributes of its current parent")
+	}
+
+	for id := parentId; id != ""; {
+		if id == objectType.Id.ValueString() {
+			return fmt.Errorf("objecttype %s is the object type itself or one of its children", parentId)
+		}
+
+		parent, _, err := r.client.ObjectType.Get(ctx, r.workspace_id, id)
+		if err != nil {
+			return fmt.Errorf("could not read objecttype %s: %s", id, err.Error())
+		}
+
+		if id == parentId {
+			if parent.ObjectSchemaId != objectType.ObjectSchemaId.ValueString() {
+				return fmt.Errorf("objecttype %s belongs to object schema %s", parentId, parent.ObjectSchemaId)
+			}
+			if parent.Inherited {
+				return fmt.Errorf("objecttype %s passes its attributes on to its children, only new object types can be created under it", parentId)
+			}
+		}
+
+		id = parent.ParentObjectTypeId
+	}
+
+	return nil
+}
+
+// Moves the object type to its planned parent and position through the
+// position endpoint, when either differs from the object type returned by
+// the API.
+func (r *objectTypeResource) moveToPosition(ctx context.Context, plan objectTypeResourceModel, objectType *models.ObjectTypeScheme) (*models.ObjectTypeScheme, error) {
+	parentId := objectType.ParentObjectTypeId
+	if !plan.ParentObjectTypeId.IsNull() && !plan.ParentObjectTypeId.IsUnknown() {
+		parentId = plan.ParentObjectTypeId.ValueString()
+	}
+
+	position := objectType.Position
+	if !plan.Position.IsNull() && !plan.Position.IsUnknown() {
+		position = int(plan.Position.ValueInt64())
+	}
+
+	if parentId == objectType.ParentObjectTypeId && position == objectType.Position {
+		return objectType, nil
+	}
+
+	moved, err := moveObjectType(ctx, r.client, r.workspace_id, objectType.Id, parentId, position)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the object type again when the response does not describe it
+	if moved.Id == "" {
+		moved, _, err = r.client.ObjectType.Get(ctx, r.workspace_id, objectType.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return moved, nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *objectTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -207,14 +333,28 @@ func (r *objectTypeResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	moved, moveErr := r.moveToPosition(ctx, plan, objectType)
+	if moveErr == nil {
+		objectType = moved
+	}
+
 	FillInformationsForObjectType(&plan, objectType)
 
-	// Set state to fully populated data
+	// Set state to fully populated data, even when the move failed so the
+	// created object type stays tracked
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if moveErr != nil {
+		resp.Diagnostics.AddError(
+			"Error creating objecttype",
+			"Could not move objecttype, unexpected error: "+moveErr.Error(),
+		)
+		return
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -273,6 +413,15 @@ func (r *objectTypeResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	objectType, err = r.moveToPosition(ctx, plan, objectType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating objecttype",
+			"Could not move objecttype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	FillInformationsForObjectType(&plan, objectType)
 
 	diags = resp.State.Set(ctx, plan)
@@ -292,8 +441,41 @@ func (r *objectTypeResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Refuse to delete an object type which still contains objects
+	objectType, response, err := r.client.ObjectType.Get(ctx, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		// Already deleted
+		if response != nil && response.Code == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting objecttype",
+			"Could not read objecttype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if objectType.ObjectCount > 0 {
+		if !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Error Deleting objecttype",
+				fmt.Sprintf("objecttype %s still contains %d objects. Set force_destroy to true to destroy them along with the object type.", objectType.Name, objectType.ObjectCount),
+			)
+			return
+		}
+
+		err = destroyContainedObjects(ctx, r.client, r.workspace_id, "objectTypeId = "+state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting objecttype",
+				"Could not destroy the objects of objecttype, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Delete existing object
-	_, _, err := r.client.ObjectType.Delete(ctx, r.workspace_id, state.Id.ValueString())
+	_, _, err = r.client.ObjectType.Delete(ctx, r.workspace_id, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting objecttype",
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectTypeRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectTypeRolesDataSource{}
+)
+
+type objectTypeRolesDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectTypeRolesDataSourceModel struct {
+	ObjectTypeId     types.String          `tfsdk:"object_type_id"`
+	IncludeInherited types.Bool            `tfsdk:"include_inherited"`
+	ObjectSchemaId   types.String          `tfsdk:"object_schema_id"`
+	Roles            []objectTypeRoleModel `tfsdk:"roles"`
+}
+
+type objectTypeRoleModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+	Users       types.Set    `tfsdk:"users"`  //<<[]string
+	Groups      types.Set    `tfsdk:"groups"` //<<[]string
+}
+
+// NewObjectTypeRolesDataSource is a helper function to simplify the provider implementation.
+func NewObjectTypeRolesDataSource() datasource.DataSource {
+	return &objectTypeRolesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectTypeRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objecttype_roles"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectTypeRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectTypeRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_type_id": schema.StringAttribute{
+				Required: true,
+			},
+			"include_inherited": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the roles of the object schema, which also apply to the object type, are returned. Defaults to true",
+			},
+			"object_schema_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The object schema of the object type",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The roles in effect on the object type, those of the object type first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"scope": schema.StringAttribute{
+							Computed:    true,
+							Description: "objecttype for the roles of the object type, objectschema for the roles inherited from the object schema",
+						},
+						"users": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The account ids of the users granted the role",
+						},
+						"groups": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The names of the groups granted the role",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Reads the roles of an object type or object schema, sorted by name.
+func (d *objectTypeRolesDataSource) roles(ctx context.Context, scope, id string) ([]objectTypeRoleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	roleIds, err := listRoles(ctx, d.client, d.workspace_id, scope, id)
+	if err != nil {
+		diags.AddError(
+			"Error Reading objecttype roles",
+			"Could not read "+scope+" roles, unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(roleIds))
+	for name := range roleIds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	roles := make([]objectTypeRoleModel, 0, len(names))
+	for _, name := range names {
+		role, _, err := getRole(ctx, d.client, d.workspace_id, roleIds[name])
+		if err != nil {
+			diags.AddError(
+				"Error Reading objecttype roles",
+				"Could not read "+scope+" role "+name+", unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		users, groups, actorDiags := roleActorSets(ctx, role)
+		diags.Append(actorDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		roles = append(roles, objectTypeRoleModel{
+			Id:          types.StringValue(roleIds[name]),
+			Name:        types.StringValue(name),
+			Description: types.StringValue(role.Description),
+			Scope:       types.StringValue(scope),
+			Users:       users,
+			Groups:      groups,
+		})
+	}
+
+	return roles, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectTypeRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectTypeRolesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectType, _, err := d.client.ObjectType.Get(ctx, d.workspace_id, state.ObjectTypeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading objecttype",
+			"Could not read objecttype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	state.ObjectSchemaId = types.StringValue(objectType.ObjectSchemaId)
+
+	state.Roles, diags = d.roles(ctx, "objecttype", state.ObjectTypeId.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.IncludeInherited.IsNull() || state.IncludeInherited.ValueBool() {
+		inherited, diags := d.roles(ctx, "objectschema", objectType.ObjectSchemaId)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Roles = append(state.Roles, inherited...)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
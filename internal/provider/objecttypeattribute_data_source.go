@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectTypeAttributeDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectTypeAttributeDataSource{}
+)
+
+type objectTypeAttributeDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+// NewObjectTypeAttributeDataSource is a helper function to simplify the provider implementation.
+func NewObjectTypeAttributeDataSource() datasource.DataSource {
+	return &objectTypeAttributeDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectTypeAttributeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objecttypeattribute"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectTypeAttributeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectTypeAttributeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := objectTypeAttributeDataAttributes()
+	attributes["object_type_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The object type of the attribute. Attributes inherited from its parents are included",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The name of the attribute",
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectTypeAttributeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectTypeAttributeDataModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectTypeAttributes, _, err := d.client.ObjectType.Attributes(ctx, d.workspace_id, state.ObjectTypeId.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading objecttypeattributes",
+			"Could not read objecttypeattributes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var matches []*models.ObjectTypeAttributeScheme
+	for _, attribute := range objectTypeAttributes {
+		if attribute.Name == state.Name.ValueString() {
+			matches = append(matches, attribute)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError(
+			"Error Reading objecttypeattribute",
+			fmt.Sprintf("Found %d attributes named %q in objecttype %s, expected exactly one.", len(matches), state.Name.ValueString(), state.ObjectTypeId.ValueString()),
+		)
+		return
+	}
+
+	objectTypeId := state.ObjectTypeId
+	diags = FillInformationsForDataObjectTypeAttribute(ctx, &state, matches[0])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Inherited attributes are defined by a parent object type
+	state.ObjectTypeId = objectTypeId
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
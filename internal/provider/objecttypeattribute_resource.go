@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,14 +23,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	//    "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &objectTypeAttributeResource{}
-	_ resource.ResourceWithConfigure   = &objectTypeAttributeResource{}
-	_ resource.ResourceWithImportState = &objectTypeAttributeResource{}
+	_ resource.Resource                   = &objectTypeAttributeResource{}
+	_ resource.ResourceWithConfigure      = &objectTypeAttributeResource{}
+	_ resource.ResourceWithImportState    = &objectTypeAttributeResource{}
+	_ resource.ResourceWithValidateConfig = &objectTypeAttributeResource{}
+	_ resource.ResourceWithModifyPlan     = &objectTypeAttributeResource{}
 )
 
 // NewObjectResource is a helper function to simplify the provider implementation.
@@ -73,6 +79,44 @@ type objectTypeAttributeResourceModel struct {
 	QlQuery                 types.String `tfsdk:"ql_query"`
 	Options                 types.String `tfsdk:"options"`
 	Position                types.Int64  `tfsdk:"position"`
+	Default                 types.Object `tfsdk:"default"`   //<<defaultBlockModel
+	Reference               types.Object `tfsdk:"reference"` //<<referenceBlockModel
+	User                    types.Object `tfsdk:"user"`      //<<userBlockModel
+	Group                   types.Object `tfsdk:"group"`
+	Project                 types.Object `tfsdk:"project"`
+	Status                  types.Object `tfsdk:"status"` //<<statusBlockModel
+}
+
+type defaultBlockModel struct {
+	Kind    types.String `tfsdk:"kind"`
+	Options types.List   `tfsdk:"options"` //<<[]string
+}
+
+type referenceBlockModel struct {
+	ObjectTypeId    types.String `tfsdk:"object_type_id"`
+	ReferenceTypeId types.String `tfsdk:"reference_type_id"`
+	AqlFilter       types.String `tfsdk:"aql_filter"`
+}
+
+type userBlockModel struct {
+	Groups types.List `tfsdk:"groups"` //<<[]string
+}
+
+type statusBlockModel struct {
+	StatusTypeIds types.List `tfsdk:"status_type_ids"` //<<[]string
+}
+
+// The kinds of the default attributes, indexed by default type id.
+var defaultTypeKinds = []string{"text", "integer", "boolean", "double", "date", "time", "datetime", "url", "email", "textarea", "select", "ipaddress"}
+
+// The attribute type of each typed block.
+var objectTypeAttributeBlockTypes = map[string]int64{
+	"default":   0,
+	"reference": 1,
+	"user":      2,
+	"group":     4,
+	"project":   6,
+	"status":    7,
 }
 
 type defaultTypeModel struct {
@@ -148,7 +192,8 @@ func (r *objectTypeAttributeResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 			"type": schema.Int64Attribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				Validators: []validator.Int64{
 					int64validator.Any(
 						int64validator.OneOf([]int64{2, 4, 7}...),
@@ -347,14 +392,268 @@ func (r *objectTypeAttributeResource) Schema(_ context.Context, _ resource.Schem
 			},
 			"position": schema.Int64Attribute{
 				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				Description: "The position of the attribute in the object type. The attribute is moved there after create and update when set",
+			},
+			"default": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(defaultTypeKinds...),
+						},
+						Description: "The kind of value: " + strings.Join(defaultTypeKinds, ", "),
+					},
+					"options": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						Description: "The options of a select attribute",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(objectTypeAttributeBlockConflicts("default")...),
+				},
+				Description: "Declares a default attribute (type 0)",
+			},
+			"reference": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"object_type_id": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "The referenced object type",
+					},
+					"reference_type_id": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "The reference type",
+					},
+					"aql_filter": schema.StringAttribute{
+						Computed:    true,
+						Optional:    true,
+						Description: "The AQL restricting the objects that can be referenced",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(objectTypeAttributeBlockConflicts("reference")...),
+				},
+				Description: "Declares a reference attribute (type 1)",
+			},
+			"user": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"groups": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Optional:    true,
+						Description: "The groups the users are picked from, all users when empty",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(objectTypeAttributeBlockConflicts("user")...),
+				},
+				Description: "Declares a user attribute (type 2)",
+			},
+			"group": schema.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: map[string]schema.Attribute{},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(objectTypeAttributeBlockConflicts("group")...),
+				},
+				Description: "Declares a group attribute (type 4)",
+			},
+			"project": schema.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: map[string]schema.Attribute{},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(objectTypeAttributeBlockConflicts("project")...),
+				},
+				Description: "Declares a project attribute (type 6)",
+			},
+			"status": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"status_type_ids": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Optional:    true,
+						Description: "The status types allowed, all status types when empty",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(objectTypeAttributeBlockConflicts("status")...),
+				},
+				Description: "Declares a status attribute (type 7)",
 			},
 		},
 	}
 }
 
+// Lists the attributes a typed block cannot be combined with: the other
+// blocks and the raw type attributes.
+func objectTypeAttributeBlockConflicts(block string) []path.Expression {
+	expressions := []path.Expression{
+		path.MatchRoot("type"),
+		path.MatchRoot("default_type_id"),
+		path.MatchRoot("type_value"),
+		path.MatchRoot("additional_value"),
+	}
+	for name := range objectTypeAttributeBlockTypes {
+		if name != block {
+			expressions = append(expressions, path.MatchRoot(name))
+		}
+	}
+	if block == "default" {
+		expressions = append(expressions, path.MatchRoot("options"))
+	}
+	if block == "reference" {
+		expressions = append(expressions, path.MatchRoot("ql_query"))
+	}
+
+	return expressions
+}
+
+// Returns the attribute type of the configured typed block, if any.
+func objectTypeAttributeBlockType(objectTypeAttribute objectTypeAttributeResourceModel) (int64, bool) {
+	blocks := map[string]types.Object{
+		"default":   objectTypeAttribute.Default,
+		"reference": objectTypeAttribute.Reference,
+		"user":      objectTypeAttribute.User,
+		"group":     objectTypeAttribute.Group,
+		"project":   objectTypeAttribute.Project,
+		"status":    objectTypeAttribute.Status,
+	}
+	for name, block := range blocks {
+		if !block.IsNull() {
+			return objectTypeAttributeBlockTypes[name], true
+		}
+	}
+
+	return 0, false
+}
+
+// ValidateConfig checks that the attribute type is declared, either with a
+// typed block or with the raw type attributes.
+func (r *objectTypeAttributeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config objectTypeAttributeResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := objectTypeAttributeBlockType(config); !ok && config.Type.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Missing attribute type",
+			"One of default, reference, user, group, project, status or type must be configured.",
+		)
+		return
+	}
+
+	if config.Default.IsNull() || config.Default.IsUnknown() {
+		return
+	}
+
+	var block defaultBlockModel
+	diags = config.Default.As(ctx, &block, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !block.Options.IsNull() && !block.Kind.IsUnknown() && block.Kind.ValueString() != "select" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default").AtName("options"),
+			"Invalid options",
+			"options can only be set on a default attribute of kind select.",
+		)
+	}
+}
+
+// ModifyPlan sets the type from the configured typed block. When the block
+// changes, the values the API derives from it are no longer known.
+func (r *objectTypeAttributeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan objectTypeAttributeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributeType, ok := objectTypeAttributeBlockType(plan)
+	if !ok {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("type"), attributeType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state objectTypeAttributeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Default.Equal(state.Default) && plan.Reference.Equal(state.Reference) && plan.User.Equal(state.User) &&
+		plan.Group.Equal(state.Group) && plan.Project.Equal(state.Project) && plan.Status.Equal(state.Status) {
+		return
+	}
+
+	var config objectTypeAttributeResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unknowns := map[string][2]attr.Value{
+		"type_value":               {config.TypeValue, types.StringUnknown()},
+		"type_value_multi":         {config.TypeValueMulti, types.ListUnknown(types.StringType)},
+		"additional_value":         {config.AdditionalValue, types.StringUnknown()},
+		"default_type":             {config.DefaultType, types.ObjectUnknown(defaultTypeAttrTypes())},
+		"reference_type":           {config.ReferenceType, types.ObjectUnknown(referenceTypeAttrTypes())},
+		"reference_object_type_id": {config.ReferenceObjectTypeId, types.StringUnknown()},
+		"ql_query":                 {config.QlQuery, types.StringUnknown()},
+		"options":                  {config.Options, types.StringUnknown()},
+	}
+	for name, values := range unknowns {
+		// Configured values are kept
+		if !values[0].IsNull() {
+			continue
+		}
+
+		diags = resp.Plan.SetAttribute(ctx, path.Root(name), values[1])
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
 func createObjectTypeAttributePayload(ctx context.Context, objectTypeAttribute objectTypeAttributeResourceModel, payload *models.ObjectTypeAttributePayloadScheme) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -407,12 +706,105 @@ func createObjectTypeAttributePayload(ctx context.Context, objectTypeAttribute o
 	payload.IncludeChildObjectTypes = objectTypeAttribute.IncludeChildObjectTypes.ValueBool()
 	payload.UniqueAttribute = objectTypeAttribute.UniqueAttribute.ValueBool()
 	payload.RegexValidation = objectTypeAttribute.RegexValidation.ValueString()
-	payload.QlQuery = objectTypeAttribute.RegexValidation.ValueString()
+	payload.QlQuery = objectTypeAttribute.QlQuery.ValueString()
 	payload.Options = objectTypeAttribute.Options.ValueString()
 
+	return createObjectTypeAttributeBlockPayload(ctx, objectTypeAttribute, payload)
+}
+
+// Sets the type values of the payload from the configured typed block.
+func createObjectTypeAttributeBlockPayload(ctx context.Context, objectTypeAttribute objectTypeAttributeResourceModel, payload *models.ObjectTypeAttributePayloadScheme) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributeType, ok := objectTypeAttributeBlockType(objectTypeAttribute)
+	if !ok {
+		return diags
+	}
+
+	Type := int(attributeType)
+	payload.Type = &Type
+	payload.DefaultTypeId = nil
+	payload.TypeValue = ""
+	payload.TypeValueMulti = nil
+	payload.AdditionalValue = ""
+
+	switch {
+	case !objectTypeAttribute.Default.IsNull():
+		var block defaultBlockModel
+		diags = objectTypeAttribute.Default.As(ctx, &block, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return diags
+		}
+
+		for id, kind := range defaultTypeKinds {
+			if kind == block.Kind.ValueString() {
+				DefaultTypeId := id
+				payload.DefaultTypeId = &DefaultTypeId
+			}
+		}
+
+		if !block.Options.IsNull() && !block.Options.IsUnknown() {
+			var options []string
+			diags = block.Options.ElementsAs(ctx, &options, false)
+			if diags.HasError() {
+				return diags
+			}
+			payload.Options = strings.Join(options, ",")
+		}
+
+	case !objectTypeAttribute.Reference.IsNull():
+		var block referenceBlockModel
+		diags = objectTypeAttribute.Reference.As(ctx, &block, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return diags
+		}
+
+		payload.TypeValue = block.ObjectTypeId.ValueString()
+		payload.AdditionalValue = block.ReferenceTypeId.ValueString()
+		payload.Iql = block.AqlFilter.ValueString()
+
+	case !objectTypeAttribute.User.IsNull():
+		var block userBlockModel
+		diags = objectTypeAttribute.User.As(ctx, &block, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return diags
+		}
+
+		if !block.Groups.IsNull() && !block.Groups.IsUnknown() {
+			diags = block.Groups.ElementsAs(ctx, &payload.TypeValueMulti, false)
+		}
+
+	case !objectTypeAttribute.Status.IsNull():
+		var block statusBlockModel
+		diags = objectTypeAttribute.Status.As(ctx, &block, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return diags
+		}
+
+		if !block.StatusTypeIds.IsNull() && !block.StatusTypeIds.IsUnknown() {
+			diags = block.StatusTypeIds.ElementsAs(ctx, &payload.TypeValueMulti, false)
+		}
+	}
+
 	return diags
 }
 
+// Moves the attribute to the planned position, when it is known and differs
+// from the position returned by the API.
+func (r *objectTypeAttributeResource) moveToPosition(ctx context.Context, objectTypeId string, position types.Int64, objectTypeAttribute *models.ObjectTypeAttributeScheme) error {
+	if position.IsNull() || position.IsUnknown() || int64(objectTypeAttribute.Position) == position.ValueInt64() {
+		return nil
+	}
+
+	err := moveObjectTypeAttribute(ctx, r.client, r.workspace_id, objectTypeId, objectTypeAttribute.ID, int(position.ValueInt64()))
+	if err != nil {
+		return err
+	}
+
+	objectTypeAttribute.Position = int(position.ValueInt64())
+	return nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *objectTypeAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -440,18 +832,29 @@ func (r *objectTypeAttributeResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	moveErr := r.moveToPosition(ctx, plan.ObjectTypeId.ValueString(), plan.Position, objectTypeAttribute)
+
 	diags = FillInformationsForObjectTypeAttribute(ctx, &plan, objectTypeAttribute)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set state to fully populated data
+	// Set state to fully populated data, even when the move failed so the
+	// created attribute stays tracked
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if moveErr != nil {
+		resp.Diagnostics.AddError(
+			"Error creating objecttypeattribute",
+			"Could not move objecttypeattribute, unexpected error: "+moveErr.Error(),
+		)
+		return
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -535,6 +938,15 @@ func (r *objectTypeAttributeResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	err = r.moveToPosition(ctx, plan.ObjectTypeId.ValueString(), plan.Position, objectTypeAttribute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating objecttypeattribute",
+			"Could not move objecttypeattribute, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	diags = FillInformationsForObjectTypeAttribute(ctx, &plan, objectTypeAttribute)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -569,9 +981,82 @@ func (r *objectTypeAttributeResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
+// ImportState accepts objectTypeId/attributeId, objectTypeId/attributeName or
+// a bare attribute id, which is looked up in the attributes of every schema.
 func (r *objectTypeAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var objectTypeAttribute *models.ObjectTypeAttributeScheme
+	var err error
+
+	objectTypeId, attribute, composite := strings.Cut(req.ID, "/")
+	if composite {
+		objectTypeAttribute, err = r.findObjectTypeAttribute(ctx, objectTypeId, attribute)
+	} else {
+		objectTypeAttribute, err = r.findSchemaAttribute(ctx, req.ID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing objecttypeattribute",
+			"Could not import objecttypeattribute "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	if objectTypeAttribute.ObjectType != nil && objectTypeAttribute.ObjectType.Id != "" {
+		objectTypeId = objectTypeAttribute.ObjectType.Id
+	}
+
+	// Save the attribute and its object type to the id and object_type_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), objectTypeAttribute.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type_id"), objectTypeId)...)
+}
+
+// Finds an attribute of an object type by id or by name.
+func (r *objectTypeAttributeResource) findObjectTypeAttribute(ctx context.Context, objectTypeId string, attribute string) (*models.ObjectTypeAttributeScheme, error) {
+	objectTypeAttributes, _, err := r.client.ObjectType.Attributes(ctx, r.workspace_id, objectTypeId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*models.ObjectTypeAttributeScheme
+	for _, objectTypeAttribute := range objectTypeAttributes {
+		if objectTypeAttribute.ID == attribute {
+			return objectTypeAttribute, nil
+		}
+		if objectTypeAttribute.Name == attribute {
+			matches = append(matches, objectTypeAttribute)
+		}
+	}
+
+	if len(matches) != 1 {
+		return nil, fmt.Errorf("%d attributes of objecttype %s match %q", len(matches), objectTypeId, attribute)
+	}
+
+	return matches[0], nil
+}
+
+// Finds an attribute by id in the attributes of all object schemas.
+func (r *objectTypeAttributeResource) findSchemaAttribute(ctx context.Context, id string) (*models.ObjectTypeAttributeScheme, error) {
+	objectSchemas, err := listObjectSchemas(ctx, r.client, r.workspace_id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, objectSchema := range objectSchemas {
+		objectTypeAttributes, _, err := r.client.ObjectSchema.Attributes(ctx, r.workspace_id, objectSchema.Id, &models.ObjectSchemaAttributesParamsScheme{
+			Extended: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, objectTypeAttribute := range objectTypeAttributes {
+			if objectTypeAttribute.ID == id && objectTypeAttribute.ObjectType != nil {
+				return objectTypeAttribute, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("objecttypeattribute not found in any object schema")
 }
 
 func defaultTypeAttrTypes() map[string]attr.Type {
@@ -581,6 +1066,33 @@ func defaultTypeAttrTypes() map[string]attr.Type {
 	}
 }
 
+func defaultBlockAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind":    types.StringType,
+		"options": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func referenceBlockAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"object_type_id":    types.StringType,
+		"reference_type_id": types.StringType,
+		"aql_filter":        types.StringType,
+	}
+}
+
+func userBlockAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"groups": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func statusBlockAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"status_type_ids": types.ListType{ElemType: types.StringType},
+	}
+}
+
 func referenceTypeAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"workspace_id": types.StringType,
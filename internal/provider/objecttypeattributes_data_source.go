@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/ctreminiom/go-atlassian/assets"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
@@ -26,15 +27,24 @@ type objectTypeAttributesDataSource struct {
 }
 
 type objectTypeAttributesDataSourceModel struct {
-	ObjectTypeId   types.String                   `tfsdk:"objecttype_id"`
-	ObjectSchemaId types.String                   `tfsdk:"objectschema_id"`
-	Attributes     []objectTypeAttributeDataModel `tfsdk:"attributes"`
+	ObjectTypeId     types.String                   `tfsdk:"objecttype_id"`
+	ObjectSchemaId   types.String                   `tfsdk:"objectschema_id"`
+	Type             types.Int64                    `tfsdk:"type"`
+	NameRegex        types.String                   `tfsdk:"name_regex"`
+	OnlyEditable     types.Bool                     `tfsdk:"only_editable"`
+	ExcludeSystem    types.Bool                     `tfsdk:"exclude_system"`
+	OnlyLabel        types.Bool                     `tfsdk:"only_label"`
+	OnlyUnique       types.Bool                     `tfsdk:"only_unique"`
+	IncludeInherited types.Bool                     `tfsdk:"include_inherited"`
+	Attributes       []objectTypeAttributeDataModel `tfsdk:"attributes"`
+	ByName           types.Map                      `tfsdk:"by_name"` //<<map[string]string
 }
 
 type objectTypeAttributeDataModel struct {
 	WorkspaceId             types.String `tfsdk:"workspace_id"`
 	GlobalId                types.String `tfsdk:"global_id"`
 	Id                      types.String `tfsdk:"id"`
+	ObjectTypeId            types.String `tfsdk:"object_type_id"`
 	Name                    types.String `tfsdk:"name"`
 	Label                   types.Bool   `tfsdk:"label"`
 	Type                    types.Int64  `tfsdk:"type"`
@@ -117,125 +127,167 @@ func (d *objectTypeAttributesDataSource) Schema(_ context.Context, _ datasource.
 					),
 				},
 			},
+			"type": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return the attributes of this type: 0 default, 1 object reference, 2 user, 3 confluence, 4 group, 5 version, 6 project, 7 status, 8 bitbucket repository, 9 opsgenie team",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "A regular expression the name of the attributes must match",
+			},
+			"only_editable": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the editable attributes",
+			},
+			"exclude_system": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Exclude the system attributes (Key, Created, Updated...)",
+			},
+			"only_label": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the label attribute",
+			},
+			"only_unique": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the unique attributes",
+			},
+			"include_inherited": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the attributes inherited from the parents of objecttype_id are returned. Defaults to true",
+			},
+			"by_name": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of the returned attributes, keyed by name. When several attributes share a name, as in an object schema, the first one is kept",
+			},
 			"attributes": schema.SetNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"workspace_id": schema.StringAttribute{
-							Computed: true,
-						},
-						"global_id": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"label": schema.BoolAttribute{
-							Computed: true,
-						},
-						"type": schema.Int64Attribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"default_type": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"id": schema.Int64Attribute{
-									Computed: true,
-								},
-								"name": schema.StringAttribute{
-									Computed: true,
-								},
-							},
-						},
-						"type_value": schema.StringAttribute{
-							Computed: true,
-						},
-						"type_value_multi": schema.ListAttribute{
-							ElementType: types.StringType,
-							Computed:    true,
-						},
-						"additional_value": schema.StringAttribute{
-							Computed: true,
-						},
-						"reference_type": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"workspace_id": schema.StringAttribute{
-									Computed: true,
-								},
-								"global_id": schema.StringAttribute{
-									Computed: true,
-								},
-								"name": schema.StringAttribute{
-									Computed: true,
-								},
-							},
-						},
-						"reference_object_type_id": schema.StringAttribute{
-							Computed: true,
-						},
-						"editable": schema.BoolAttribute{
-							Computed: true,
-						},
-						"system": schema.BoolAttribute{
-							Computed: true,
-						},
-						"indexed": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Describes if this object type attribute is indexed. For an indexed attribute the AQL search will be faster, but this will affect memory consumption.",
-						},
-						"sortable": schema.BoolAttribute{
-							Computed: true,
-						},
-						"summable": schema.BoolAttribute{
-							Computed: true,
-						},
-						"minimum_cardinality": schema.Int64Attribute{
-							Computed: true,
-						},
-						"maximum_cardinality": schema.Int64Attribute{
-							Computed: true,
-						},
-						"suffix": schema.StringAttribute{
-							Computed: true,
-						},
-						"removable": schema.BoolAttribute{
-							Computed: true,
-						},
-						"object_attribute_exists": schema.BoolAttribute{
-							Computed: true,
-						},
-						"hidden": schema.BoolAttribute{
-							Computed: true,
-						},
-						"include_child_object_types": schema.BoolAttribute{
-							Computed: true,
-						},
-						"unique_attribute": schema.BoolAttribute{
-							Computed: true,
-						},
-						"regex_validation": schema.StringAttribute{
-							Computed: true,
-						},
-						"ql_query": schema.StringAttribute{
-							Computed: true,
-						},
-						"options": schema.StringAttribute{
-							Computed: true,
-						},
-						"position": schema.Int64Attribute{
-							Computed: true,
-						},
-					},
+					Attributes: objectTypeAttributeDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// The computed attributes describing an object type attribute.
+func objectTypeAttributeDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workspace_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"global_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"object_type_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The object type defining the attribute",
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"label": schema.BoolAttribute{
+			Computed: true,
+		},
+		"type": schema.Int64Attribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"default_type": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Computed: true,
+				},
+				"name": schema.StringAttribute{
+					Computed: true,
 				},
 			},
 		},
+		"type_value": schema.StringAttribute{
+			Computed: true,
+		},
+		"type_value_multi": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"additional_value": schema.StringAttribute{
+			Computed: true,
+		},
+		"reference_type": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"workspace_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"global_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+		"reference_object_type_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"editable": schema.BoolAttribute{
+			Computed: true,
+		},
+		"system": schema.BoolAttribute{
+			Computed: true,
+		},
+		"indexed": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Describes if this object type attribute is indexed. For an indexed attribute the AQL search will be faster, but this will affect memory consumption.",
+		},
+		"sortable": schema.BoolAttribute{
+			Computed: true,
+		},
+		"summable": schema.BoolAttribute{
+			Computed: true,
+		},
+		"minimum_cardinality": schema.Int64Attribute{
+			Computed: true,
+		},
+		"maximum_cardinality": schema.Int64Attribute{
+			Computed: true,
+		},
+		"suffix": schema.StringAttribute{
+			Computed: true,
+		},
+		"removable": schema.BoolAttribute{
+			Computed: true,
+		},
+		"object_attribute_exists": schema.BoolAttribute{
+			Computed: true,
+		},
+		"hidden": schema.BoolAttribute{
+			Computed: true,
+		},
+		"include_child_object_types": schema.BoolAttribute{
+			Computed: true,
+		},
+		"unique_attribute": schema.BoolAttribute{
+			Computed: true,
+		},
+		"regex_validation": schema.StringAttribute{
+			Computed: true,
+		},
+		"ql_query": schema.StringAttribute{
+			Computed: true,
+		},
+		"options": schema.StringAttribute{
+			Computed: true,
+		},
+		"position": schema.Int64Attribute{
+			Computed: true,
+		},
 	}
 }
 
@@ -252,7 +304,10 @@ func (d *objectTypeAttributesDataSource) Read(ctx context.Context, req datasourc
 	var objectTypeAttributes []*models.ObjectTypeAttributeScheme
 	var err error
 	if state.ObjectTypeId.ValueString() != "" {
-		objectTypeAttributes, _, err = d.client.ObjectType.Attributes(ctx, d.workspace_id, state.ObjectTypeId.ValueString(), nil)
+		var payload *models.ObjectTypeAttributesParamsScheme = &models.ObjectTypeAttributesParamsScheme{
+			ExcludeParentAttributes: !state.IncludeInherited.IsNull() && !state.IncludeInherited.ValueBool(),
+		}
+		objectTypeAttributes, _, err = d.client.ObjectType.Attributes(ctx, d.workspace_id, state.ObjectTypeId.ValueString(), payload)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading objecttypeattributes",
@@ -280,14 +335,52 @@ func (d *objectTypeAttributesDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	state.Attributes = make([]objectTypeAttributeDataModel, len(objectTypeAttributes))
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid name_regex",
+				"Could not compile name_regex, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	state.Attributes = []objectTypeAttributeDataModel{}
+	byName := map[string]string{}
 
-	for index, attr := range objectTypeAttributes {
-		diags = FillInformationsForDataObjectTypeAttribute(ctx, &state.Attributes[index], attr)
+	for _, attr := range objectTypeAttributes {
+		if !state.Type.IsNull() && int64(attr.Type) != state.Type.ValueInt64() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(attr.Name) {
+			continue
+		}
+		if (state.OnlyEditable.ValueBool() && !attr.Editable) ||
+			(state.ExcludeSystem.ValueBool() && attr.System) ||
+			(state.OnlyLabel.ValueBool() && !attr.Label) ||
+			(state.OnlyUnique.ValueBool() && !attr.UniqueAttribute) {
+			continue
+		}
+
+		var attribute objectTypeAttributeDataModel
+		diags = FillInformationsForDataObjectTypeAttribute(ctx, &attribute, attr)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		state.Attributes = append(state.Attributes, attribute)
+
+		if _, ok := byName[attr.Name]; !ok {
+			byName[attr.Name] = attr.ID
+		}
+	}
+
+	state.ByName, diags = types.MapValueFrom(ctx, types.StringType, byName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set refreshed state
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &objectTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectTypesDataSource{}
+)
+
+type objectTypesDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type objectTypesDataSourceModel struct {
+	ObjectSchemaId     types.String `tfsdk:"object_schema_id"`
+	Name               types.String `tfsdk:"name"`
+	ParentObjectTypeId types.String `tfsdk:"parent_object_type_id"`
+	ExcludeAbstract    types.Bool   `tfsdk:"exclude_abstract"`
+	ObjectTypes        types.List   `tfsdk:"object_types"` //<<[]objectTypeDataModel + depth and icon
+}
+
+// NewObjectTypesDataSource is a helper function to simplify the provider implementation.
+func NewObjectTypesDataSource() datasource.DataSource {
+	return &objectTypesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *objectTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objecttypes"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *objectTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *objectTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_schema_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The object schema of the object types",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the object types with this name",
+			},
+			"parent_object_type_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the direct children of this object type",
+			},
+			"exclude_abstract": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Exclude the abstract object types",
+			},
+			"object_types": schema.ListAttribute{
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: objectTypeListAttrTypes(),
+				},
+				Description: "The matching object types, with the attributes of the assets_objecttype data source, their depth in the hierarchy (0 for root types) and their icon",
+			},
+		},
+	}
+}
+
+// Depth of an object type in the hierarchy of its schema.
+func objectTypeDepth(objectType *models.ObjectTypeScheme, objectTypes map[string]*models.ObjectTypeScheme) int {
+	depth := 0
+	for parent, ok := objectTypes[objectType.ParentObjectTypeId]; ok && depth < len(objectTypes); parent, ok = objectTypes[parent.ParentObjectTypeId] {
+		depth++
+	}
+	return depth
+}
+
+func objectTypeListValue(ctx context.Context, assetsObjectType *models.ObjectTypeScheme, depth int) (types.Object, diag.Diagnostics) {
+	var objectType objectTypeDataModel
+	FillInformationsForDataObjectType(&objectType, assetsObjectType)
+
+	value, diags := types.ObjectValueFrom(ctx, objectTypeAttrTypes(), objectType)
+	if diags.HasError() {
+		return value, diags
+	}
+
+	var d diag.Diagnostics
+	iconValue := types.ObjectNull(iconAttrTypes())
+	if assetsObjectType.Icon != nil {
+		var icon IconModel
+		FillInformationForIcon(&icon, assetsObjectType.Icon)
+
+		iconValue, d = types.ObjectValueFrom(ctx, iconAttrTypes(), icon)
+		diags.Append(d...)
+		if diags.HasError() {
+			return value, diags
+		}
+	}
+
+	attributes := value.Attributes()
+	attributes["depth"] = types.Int64Value(int64(depth))
+	attributes["icon"] = iconValue
+
+	value, d = types.ObjectValue(objectTypeListAttrTypes(), attributes)
+	diags.Append(d...)
+	return value, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *objectTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state objectTypesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Abstract object types are needed to compute the depth, they are filtered below
+	objecttypes, _, err := d.client.ObjectSchema.ObjectTypes(ctx, d.workspace_id, state.ObjectSchemaId.ValueString(), false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading objecttypes",
+			"Could not read objecttypes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	byId := make(map[string]*models.ObjectTypeScheme, len(objecttypes))
+	for _, objecttype := range objecttypes {
+		byId[objecttype.Id] = objecttype
+	}
+
+	values := []attr.Value{}
+	for _, objecttype := range objecttypes {
+		if !state.Name.IsNull() && objecttype.Name != state.Name.ValueString() {
+			continue
+		}
+		if !state.ParentObjectTypeId.IsNull() && objecttype.ParentObjectTypeId != state.ParentObjectTypeId.ValueString() {
+			continue
+		}
+		if state.ExcludeAbstract.ValueBool() && objecttype.AbstractObjectType {
+			continue
+		}
+
+		value, diags := objectTypeListValue(ctx, objecttype, objectTypeDepth(objecttype, byId))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		values = append(values, value)
+	}
+
+	state.ObjectTypes, diags = types.ListValue(types.ObjectType{AttrTypes: objectTypeListAttrTypes()}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func objectTypeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"workspace_id":                 types.StringType,
+		"global_id":                    types.StringType,
+		"id":                           types.StringType,
+		"name":                         types.StringType,
+		"description":                  types.StringType,
+		"icon_id":                      types.StringType,
+		"position":                     types.Int64Type,
+		"created":                      types.StringType,
+		"updated":                      types.StringType,
+		"object_count":                 types.Int64Type,
+		"parent_object_type_id":        types.StringType,
+		"object_schema_id":             types.StringType,
+		"inherited":                    types.BoolType,
+		"abstract_object_type":         types.BoolType,
+		"parent_object_type_inherited": types.BoolType,
+	}
+}
+
+func objectTypeListAttrTypes() map[string]attr.Type {
+	attrTypes := objectTypeAttrTypes()
+	attrTypes["depth"] = types.Int64Type
+	attrTypes["icon"] = types.ObjectType{AttrTypes: iconAttrTypes()}
+	return attrTypes
+}
+
+func iconAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":    types.StringType,
+		"name":  types.StringType,
+		"url16": types.StringType,
+		"url48": types.StringType,
+	}
+}
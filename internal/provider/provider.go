@@ -41,11 +41,13 @@ type AssetsProviderModel struct {
 type featuresModel struct {
 	DestroyObject                 types.Bool   `tfsdk:"destroy_object"`
 	ObsoleteObjectTypeAttributeId types.String `tfsdk:"obsolete_objecttypeattribute_id"`
+	ObsoleteStatus                types.String `tfsdk:"obsolete_status"`
 }
 
 type features struct {
 	DestroyObject                 bool
 	ObsoleteObjectTypeAttributeId string
+	ObsoleteStatus                string
 }
 
 // Custom client to store the workspace ID.
@@ -91,6 +93,10 @@ func (p *AssetsProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 						Optional:    true,
 						Description: "The objecttypeattribute ID of the obsolete attribute.",
 					},
+					"obsolete_status": schema.StringAttribute{
+						Optional:    true,
+						Description: "The value written to the obsolete attribute, e.g. the name or ID of a status type. Defaults to Obsolete.",
+					},
 				},
 			},
 		},
@@ -155,6 +161,10 @@ func (p *AssetsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	workspace_id := os.Getenv("ASSETS_WORKSPACE_ID")
 	destroy_object_env := os.Getenv("ASSETS_DESTROY_OBJECT")
 	obsolete_objecttypeattribute_id := os.Getenv("ASSETS_OBJECTTYPEATTRIBUTE_ID")
+	obsolete_status := os.Getenv("ASSETS_OBSOLETE_STATUS")
+	if obsolete_status == "" {
+		obsolete_status = "Obsolete"
+	}
 
 	destroy_object, err := strconv.ParseBool(destroy_object_env)
 	if err != nil {
@@ -192,11 +202,16 @@ func (p *AssetsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		if !feats.ObsoleteObjectTypeAttributeId.IsNull() {
 			obsolete_objecttypeattribute_id = feats.ObsoleteObjectTypeAttributeId.ValueString()
 		}
+
+		if !feats.ObsoleteStatus.IsNull() {
+			obsolete_status = feats.ObsoleteStatus.ValueString()
+		}
 	}
 
 	features := features{
 		DestroyObject:                 destroy_object,
 		ObsoleteObjectTypeAttributeId: obsolete_objecttypeattribute_id,
+		ObsoleteStatus:                obsolete_status,
 	}
 
 	// If any of the expected configurations are missing, return
@@ -274,17 +289,37 @@ func (p *AssetsProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewObjectTypeResource,
 		NewObjectTypeAttributeResource,
 		NewObjectSchemaResource,
+		NewObjectSchemaPropertiesResource,
+		NewObjectSchemaDefinitionResource,
+		NewObjectSchemaRoleAssignmentResource,
+		NewObjectTypeRoleAssignmentResource,
+		NewObjectReferenceResource,
+		NewObjectsResource,
+		NewObjectImportResource,
+		NewObjectTypeOwnershipResource,
+		NewStatusTypeResource,
+		NewReferenceTypeResource,
 	}
 }
 
 func (p *AssetsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewObjectDataSource,
+		NewObjectsDataSource,
+		NewObjectHistoryDataSource,
+		NewObjectReferencesDataSource,
+		NewObjectConnectedTicketsDataSource,
+		NewStatusTypesDataSource,
+		NewReferenceTypesDataSource,
 		NewIconDataSource,
 		NewGlobalIconsDataSource,
 		NewObjectTypeDataSource,
+		NewObjectTypesDataSource,
+		NewObjectTypeRolesDataSource,
 		NewObjectTypeAttributesDataSource,
+		NewObjectTypeAttributeDataSource,
 		NewObjectSchemaDataSource,
+		NewObjectSchemasDataSource,
 	}
 }
 
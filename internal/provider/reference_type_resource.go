@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &referenceTypeResource{}
+	_ resource.ResourceWithConfigure   = &referenceTypeResource{}
+	_ resource.ResourceWithImportState = &referenceTypeResource{}
+)
+
+// NewReferenceTypeResource is a helper function to simplify the provider implementation.
+func NewReferenceTypeResource() resource.Resource {
+	return &referenceTypeResource{}
+}
+
+// referenceTypeResource is the resource implementation.
+type referenceTypeResource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type referenceTypeResourceModel struct {
+	WorkspaceId    types.String `tfsdk:"workspace_id"`
+	GlobalId       types.String `tfsdk:"global_id"`
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Color          types.String `tfsdk:"color"`
+	Url16          types.String `tfsdk:"url16"`
+	Removable      types.Bool   `tfsdk:"removable"`
+	ObjectSchemaId types.String `tfsdk:"object_schema_id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *referenceTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Metadata returns the resource type name.
+func (r *referenceTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reference_type"
+}
+
+// Schema defines the schema for the resource.
+func (r *referenceTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"global_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"color": schema.StringAttribute{
+				Required:    true,
+				Description: "The colour of the reference type, as a hexadecimal code",
+			},
+			"url16": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The URL of the 16px icon of the reference type",
+			},
+			"removable": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_schema_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object schema the reference type belongs to. The reference type is global when not set",
+			},
+		},
+	}
+}
+
+func createReferenceTypePayload(referenceType referenceTypeResourceModel) *referenceTypePayload {
+	return &referenceTypePayload{
+		Name:           referenceType.Name.ValueString(),
+		Description:    referenceType.Description.ValueString(),
+		Color:          referenceType.Color.ValueString(),
+		ObjectSchemaId: referenceType.ObjectSchemaId.ValueString(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *referenceTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan referenceTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	referenceType, err := createReferenceType(ctx, r.client, r.workspace_id, createReferenceTypePayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating referencetype",
+			"Could not create referencetype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	FillInformationsForReferenceType(&plan, referenceType)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *referenceTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state referenceTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	referenceType, response, err := getReferenceType(ctx, r.client, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		if response == nil || response.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Error Reading referencetype",
+				"Could not read referencetype, unexpected error: "+err.Error(),
+			)
+		} else {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	FillInformationsForReferenceType(&state, referenceType)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *referenceTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan referenceTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	referenceType, err := updateReferenceType(ctx, r.client, r.workspace_id, plan.Id.ValueString(), createReferenceTypePayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating referencetype",
+			"Could not update referencetype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	FillInformationsForReferenceType(&plan, referenceType)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *referenceTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state referenceTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteReferenceType(ctx, r.client, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting referencetype",
+			"Could not delete referencetype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *referenceTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &referenceTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &referenceTypesDataSource{}
+)
+
+type referenceTypesDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type referenceTypesDataSourceModel struct {
+	ObjectSchemaId types.String                 `tfsdk:"object_schema_id"`
+	Name           types.String                 `tfsdk:"name"`
+	ReferenceTypes []referenceTypeResourceModel `tfsdk:"reference_types"`
+	ByName         types.Map                    `tfsdk:"by_name"` //<<map[string]string
+}
+
+// NewReferenceTypesDataSource is a helper function to simplify the provider implementation.
+func NewReferenceTypesDataSource() datasource.DataSource {
+	return &referenceTypesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *referenceTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reference_types"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *referenceTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *referenceTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_schema_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return the reference types of this object schema. The global reference types are returned when not set",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the reference types with this name",
+			},
+			"reference_types": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workspace_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"global_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"color": schema.StringAttribute{
+							Computed: true,
+						},
+						"url16": schema.StringAttribute{
+							Computed: true,
+						},
+						"removable": schema.BoolAttribute{
+							Computed: true,
+						},
+						"object_schema_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The object schema the reference type belongs to, null for global reference types",
+						},
+					},
+				},
+			},
+			"by_name": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of the returned reference types, keyed by name",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *referenceTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state referenceTypesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	referenceTypes, err := listReferenceTypes(ctx, d.client, d.workspace_id, state.ObjectSchemaId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading referencetypes",
+			"Could not read referencetypes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.ReferenceTypes = []referenceTypeResourceModel{}
+	byName := map[string]string{}
+	for _, assetsReferenceType := range referenceTypes {
+		if !state.Name.IsNull() && assetsReferenceType.Name != state.Name.ValueString() {
+			continue
+		}
+
+		var referenceType referenceTypeResourceModel
+		FillInformationsForReferenceType(&referenceType, assetsReferenceType)
+		state.ReferenceTypes = append(state.ReferenceTypes, referenceType)
+
+		if _, ok := byName[assetsReferenceType.Name]; !ok {
+			byName[assetsReferenceType.Name] = assetsReferenceType.ID
+		}
+	}
+
+	state.ByName, diags = types.MapValueFrom(ctx, types.StringType, byName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &roleAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &roleAssignmentResource{}
+	_ resource.ResourceWithImportState = &roleAssignmentResource{}
+)
+
+// NewObjectSchemaRoleAssignmentResource is a helper function to simplify the provider implementation.
+func NewObjectSchemaRoleAssignmentResource() resource.Resource {
+	return &roleAssignmentResource{
+		scope:           "objectschema",
+		scopeAttribute:  "object_schema_id",
+		scopeImportName: "objectSchemaId",
+		roleDescription: "The name of the role, e.g. Object Schema Managers, Object Schema Developers or Object Schema Users",
+	}
+}
+
+// NewObjectTypeRoleAssignmentResource is a helper function to simplify the provider implementation.
+func NewObjectTypeRoleAssignmentResource() resource.Resource {
+	return &roleAssignmentResource{
+		scope:           "objecttype",
+		scopeAttribute:  "object_type_id",
+		scopeImportName: "objectTypeId",
+		roleDescription: "The name of the role of the object type",
+	}
+}
+
+// roleAssignmentResource is the resource implementation, shared by the roles
+// of object schemas and object types.
+type roleAssignmentResource struct {
+	client       *assets.Client
+	workspace_id string
+
+	scope           string // objectschema or objecttype, as in the roles endpoint
+	scopeAttribute  string // the attribute holding the object schema or object type id
+	scopeImportName string // the name of that id in the import identifier
+	roleDescription string
+}
+
+// The id of the object schema or object type is named after the scope, so the
+// model is read and written attribute by attribute instead of with tfsdk tags.
+type roleAssignmentResourceModel struct {
+	Id      types.String
+	ScopeId types.String
+	Role    types.String
+	Users   types.Set //<<[]string
+	Groups  types.Set //<<[]string
+}
+
+// Implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+func (r *roleAssignmentResource) getModel(ctx context.Context, source attributeGetter) (roleAssignmentResourceModel, diag.Diagnostics) {
+	var model roleAssignmentResourceModel
+	var diags diag.Diagnostics
+
+	diags.Append(source.GetAttribute(ctx, path.Root("id"), &model.Id)...)
+	diags.Append(source.GetAttribute(ctx, path.Root(r.scopeAttribute), &model.ScopeId)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("role"), &model.Role)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("users"), &model.Users)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("groups"), &model.Groups)...)
+
+	return model, diags
+}
+
+func (r *roleAssignmentResource) setModel(ctx context.Context, state *tfsdk.State, model roleAssignmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), model.Id)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.scopeAttribute), model.ScopeId)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("role"), model.Role)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("users"), model.Users)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("groups"), model.Groups)...)
+
+	return diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Metadata returns the resource type name.
+func (r *roleAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.scope + "_role_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "The id of the role",
+			},
+			r.scopeAttribute: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: r.roleDescription,
+			},
+			"users": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, nil)),
+				Description: "The account ids of the users granted the role. Users added outside of Terraform are removed",
+			},
+			"groups": schema.SetAttribute{
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, nil)),
+				Description: "The names of the groups granted the role. Groups added outside of Terraform are removed",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	plan, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId, err := lookupRoleId(ctx, r.client, r.workspace_id, r.scope, plan.ScopeId.ValueString(), plan.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating "+r.scope+" role assignment",
+			"Could not find "+r.scope+" role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = assignRoleActors(ctx, r.client, r.workspace_id, roleId, plan.Users, plan.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating "+r.scope+" role assignment",
+			"Could not assign "+r.scope+" role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// The planned users and groups are kept, Read reports how the API stores them
+	plan.Id = types.StringValue(roleId)
+
+	// Set state to fully populated data
+	diags = r.setModel(ctx, &resp.State, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	state, diags := r.getModel(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, response, err := getRole(ctx, r.client, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		if response == nil || response.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Error Reading "+r.scope+" role assignment",
+				"Could not read "+r.scope+" role, unexpected error: "+err.Error(),
+			)
+		} else {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	// The role name is null after an import, which only saves the role id
+	if state.Role.IsNull() {
+		state.Role = types.StringValue(role.Name)
+	}
+	state.Users, state.Groups, diags = roleActorSets(ctx, role)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = r.setModel(ctx, &resp.State, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	plan, diags := r.getModel(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := assignRoleActors(ctx, r.client, r.workspace_id, plan.Id.ValueString(), plan.Users, plan.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating "+r.scope+" role assignment",
+			"Could not assign "+r.scope+" role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = r.setModel(ctx, &resp.State, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes every user and group from the role.
+func (r *roleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	state, diags := r.getModel(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setRoleActors(ctx, r.client, r.workspace_id, state.Id.ValueString(), nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting "+r.scope+" role assignment",
+			"Could not remove the actors of "+r.scope+" role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState accepts objectSchemaId/roleName or objectTypeId/roleName.
+func (r *roleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scopeId, roleName, ok := strings.Cut(req.ID, "/")
+	if !ok || scopeId == "" || roleName == "" {
+		resp.Diagnostics.AddError(
+			"Error Importing "+r.scope+" role assignment",
+			"Expected an import identifier of the form "+r.scopeImportName+"/roleName, got: "+req.ID,
+		)
+		return
+	}
+
+	roleId, err := lookupRoleId(ctx, r.client, r.workspace_id, r.scope, scopeId, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing "+r.scope+" role assignment",
+			"Could not find "+r.scope+" role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the role and its object schema or object type to the id and scope attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.scopeAttribute), scopeId)...)
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &statusTypeResource{}
+	_ resource.ResourceWithConfigure   = &statusTypeResource{}
+	_ resource.ResourceWithImportState = &statusTypeResource{}
+)
+
+// NewStatusTypeResource is a helper function to simplify the provider implementation.
+func NewStatusTypeResource() resource.Resource {
+	return &statusTypeResource{}
+}
+
+// statusTypeResource is the resource implementation.
+type statusTypeResource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type statusTypeResourceModel struct {
+	WorkspaceId    types.String `tfsdk:"workspace_id"`
+	GlobalId       types.String `tfsdk:"global_id"`
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Category       types.Int64  `tfsdk:"category"`
+	ObjectSchemaId types.String `tfsdk:"object_schema_id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *statusTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Metadata returns the resource type name.
+func (r *statusTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_type"
+}
+
+// Schema defines the schema for the resource.
+func (r *statusTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"global_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"category": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2),
+				},
+				Description: "The category of the status: 0 inactive, 1 active, 2 pending",
+			},
+			"object_schema_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The object schema the status belongs to. The status is global when not set",
+			},
+		},
+	}
+}
+
+func createStatusTypePayload(statusType statusTypeResourceModel) *statusTypePayload {
+	return &statusTypePayload{
+		Name:           statusType.Name.ValueString(),
+		Description:    statusType.Description.ValueString(),
+		Category:       int(statusType.Category.ValueInt64()),
+		ObjectSchemaId: statusType.ObjectSchemaId.ValueString(),
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *statusTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan statusTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusType, err := createStatusType(ctx, r.client, r.workspace_id, createStatusTypePayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating statustype",
+			"Could not create statustype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	FillInformationsForStatusType(&plan, statusType)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *statusTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state statusTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusType, response, err := getStatusType(ctx, r.client, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		if response == nil || response.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Error Reading statustype",
+				"Could not read statustype, unexpected error: "+err.Error(),
+			)
+		} else {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	FillInformationsForStatusType(&state, statusType)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *statusTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan statusTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusType, err := updateStatusType(ctx, r.client, r.workspace_id, plan.Id.ValueString(), createStatusTypePayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating statustype",
+			"Could not update statustype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	FillInformationsForStatusType(&plan, statusType)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *statusTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state statusTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteStatusType(ctx, r.client, r.workspace_id, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting statustype",
+			"Could not delete statustype, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *statusTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ctreminiom/go-atlassian/assets"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &statusTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusTypesDataSource{}
+)
+
+type statusTypesDataSource struct {
+	client       *assets.Client
+	workspace_id string
+}
+
+type statusTypesDataSourceModel struct {
+	ObjectSchemaId types.String              `tfsdk:"object_schema_id"`
+	Name           types.String              `tfsdk:"name"`
+	StatusTypes    []statusTypeResourceModel `tfsdk:"status_types"`
+	ByName         types.Map                 `tfsdk:"by_name"` //<<map[string]string
+}
+
+// NewStatusTypesDataSource is a helper function to simplify the provider implementation.
+func NewStatusTypesDataSource() datasource.DataSource {
+	return &statusTypesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *statusTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_types"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *statusTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	assetsClient, ok := req.ProviderData.(AssetsProviderClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *assets.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = assetsClient.Client
+	r.workspace_id = assetsClient.WorkspaceId
+}
+
+// Schema defines the schema for the data source.
+func (d *statusTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"object_schema_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return the status types of this object schema. The global status types are returned when not set",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the status types with this name",
+			},
+			"status_types": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workspace_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"global_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"category": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The category of the status: 0 inactive, 1 active, 2 pending",
+						},
+						"object_schema_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The object schema the status belongs to, null for global statuses",
+						},
+					},
+				},
+			},
+			"by_name": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The id of the returned status types, keyed by name",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *statusTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state statusTypesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusTypes, err := listStatusTypes(ctx, d.client, d.workspace_id, state.ObjectSchemaId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading statustypes",
+			"Could not read statustypes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.StatusTypes = []statusTypeResourceModel{}
+	byName := map[string]string{}
+	for _, assetsStatusType := range statusTypes {
+		if !state.Name.IsNull() && assetsStatusType.Name != state.Name.ValueString() {
+			continue
+		}
+
+		var statusType statusTypeResourceModel
+		FillInformationsForStatusType(&statusType, assetsStatusType)
+		state.StatusTypes = append(state.StatusTypes, statusType)
+
+		if _, ok := byName[assetsStatusType.Name]; !ok {
+			byName[assetsStatusType.Name] = assetsStatusType.ID
+		}
+	}
+
+	state.ByName, diags = types.MapValueFrom(ctx, types.StringType, byName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}